@@ -597,7 +597,7 @@ func (bc *BabylonController) QueryCurrentEpoch() (uint64, error) {
 func (bc *BabylonController) QueryVotesAtHeight(height uint64) ([]bbntypes.BIP340PubKey, error) {
 	res, err := bc.bbnClient.QueryClient.VotesAtHeight(height)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query BTC delegations: %w", err)
+		return nil, fmt.Errorf("failed to query votes at height %d: %w", height, err)
 	}
 
 	return res.BtcPks, nil
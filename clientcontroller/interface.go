@@ -3,6 +3,7 @@ package clientcontroller
 import (
 	"cosmossdk.io/math"
 	"fmt"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
 	btcstakingtypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -43,6 +44,9 @@ type ClientController interface {
 	// UnjailFinalityProvider sends an unjail transaction to the consumer chain
 	UnjailFinalityProvider(fpPk *btcec.PublicKey) (*types.TxResponse, error)
 
+	// QueryFinalityProvider queries the finality provider registered on the consumer chain
+	QueryFinalityProvider(fpPk *btcec.PublicKey) (*btcstakingtypes.QueryFinalityProviderResponse, error)
+
 	// QueryFinalityProviderVotingPower queries the voting power of the finality provider at a given height
 	QueryFinalityProviderVotingPower(fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error)
 
@@ -64,6 +68,10 @@ type ClientController interface {
 	// QueryBlocks returns a list of blocks from startHeight to endHeight
 	QueryBlocks(startHeight, endHeight uint64, limit uint32) ([]*types.BlockInfo, error)
 
+	// QueryVotesAtHeight returns the BTC public keys of the finality providers that have voted
+	// at the given height
+	QueryVotesAtHeight(height uint64) ([]bbntypes.BIP340PubKey, error)
+
 	// QueryBestBlock queries the tip block of the consumer chain
 	QueryBestBlock() (*types.BlockInfo, error)
 
@@ -0,0 +1,40 @@
+package eotsmanager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+// AppendAuditRecords appends the given records to the audit log in the EOTS db
+func (lm *LocalEOTSManager) AppendAuditRecords(records []*types.AuditRecord) error {
+	if err := lm.es.AppendAuditRecords(records); err != nil {
+		return fmt.Errorf("failed to append audit records: %w", err)
+	}
+
+	return nil
+}
+
+// ListAuditRecords returns at most limit audit records matching the filter starting from
+// the record of ID startID, along with the ID of the next matching record, which is zero
+// if there is none
+func (lm *LocalEOTSManager) ListAuditRecords(filter *types.AuditFilter, startID uint64, limit uint64) ([]*types.AuditRecord, uint64, error) {
+	records, nextID, err := lm.es.ListAuditRecords(filter, startID, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit records: %w", err)
+	}
+
+	return records, nextID, nil
+}
+
+// PruneAuditRecords deletes the audit records older than the given retention, and returns
+// the number of the deleted records
+func (lm *LocalEOTSManager) PruneAuditRecords(retention time.Duration) (int, error) {
+	numPruned, err := lm.es.PruneAuditRecords(time.Now().Add(-retention))
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune audit records: %w", err)
+	}
+
+	return numPruned, nil
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"fmt"
+
+	"golang.org/x/mod/semver"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/randgenerator"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+	"github.com/babylonlabs-io/finality-provider/version"
+)
+
+// MinServerVersion is the minimum version of the EOTS manager that this client works with
+const MinServerVersion = "0.2.0"
+
+// RequiredCapabilities is the list of the capabilities of the EOTS manager that this client
+// relies on without a fallback
+var RequiredCapabilities = []string{
+	types.CapabilitySignEOTSBatch,
+}
+
+// CheckCompatibility checks the EOTS manager described by the info is compatible with this
+// client, i.e., it has the same major version and is not older than MinServerVersion, derives
+// the randomness by the same scheme, and has all the RequiredCapabilities
+func CheckCompatibility(info *types.ServerInfo) error {
+	if info.Version == "" {
+		return fmt.Errorf("the EOTS manager predates the version handshake, upgrade it to v%s or later", MinServerVersion)
+	}
+
+	serverVersion := "v" + info.Version
+	if !semver.IsValid(serverVersion) {
+		return fmt.Errorf("the EOTS manager reports an invalid version %s", info.Version)
+	}
+	clientVersion := "v" + version.SemanticVersion()
+	if semver.Major(serverVersion) != semver.Major(clientVersion) {
+		return fmt.Errorf("the EOTS manager version %s has a different major version from v%s", serverVersion, version.SemanticVersion())
+	}
+	if semver.Compare(serverVersion, "v"+MinServerVersion) < 0 {
+		return fmt.Errorf("the EOTS manager version %s is older than the minimum version v%s, upgrade it", serverVersion, MinServerVersion)
+	}
+
+	if info.RandScheme != randgenerator.SchemeID {
+		return fmt.Errorf("the EOTS manager derives the randomness by the scheme %q, expected %q", info.RandScheme, randgenerator.SchemeID)
+	}
+
+	for _, c := range RequiredCapabilities {
+		if !info.HasCapability(c) {
+			return fmt.Errorf("the EOTS manager version %s lacks the capability %s", serverVersion, c)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+)
+
+const (
+	defaultRPCTimeout       = 30 * time.Second
+	defaultKeepaliveTime    = 30 * time.Second
+	defaultKeepaliveTimeout = 10 * time.Second
+	defaultMaxBackoffDelay  = 10 * time.Second
+	defaultMaxRetries       = 3
+	defaultRetryDelay       = 500 * time.Millisecond
+)
+
+// Config is the connection config of the gRPC client of the EOTS manager
+type Config struct {
+	RPCTimeout       time.Duration `long:"rpctimeout" description:"The deadline of each RPC to the EOTS manager"`
+	KeepaliveTime    time.Duration `long:"keepalivetime" description:"The interval of the keepalive pings to the EOTS manager, which should not be shorter than the minimum interval the EOTS manager permits"`
+	KeepaliveTimeout time.Duration `long:"keepalivetimeout" description:"The duration to wait for the reply to a keepalive ping before the connection to the EOTS manager is considered broken"`
+	MaxBackoffDelay  time.Duration `long:"maxbackoffdelay" description:"The maximum delay between the attempts to reconnect to the EOTS manager"`
+	MaxRetries       uint          `long:"maxretries" description:"The maximum number of retries of the idempotent RPCs, i.e., Ping, KeyRecord and CreateRandomnessPairList, while the EOTS manager is unavailable"`
+	RetryDelay       time.Duration `long:"retrydelay" description:"The initial delay between the retries of the idempotent RPCs, which grows exponentially"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		RPCTimeout:       defaultRPCTimeout,
+		KeepaliveTime:    defaultKeepaliveTime,
+		KeepaliveTimeout: defaultKeepaliveTimeout,
+		MaxBackoffDelay:  defaultMaxBackoffDelay,
+		MaxRetries:       defaultMaxRetries,
+		RetryDelay:       defaultRetryDelay,
+	}
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.RPCTimeout <= 0 {
+		return fmt.Errorf("the RPC timeout should be positive")
+	}
+
+	if cfg.KeepaliveTime < eotscfg.MinKeepaliveTime {
+		return fmt.Errorf("the keepalive time should not be shorter than %v", eotscfg.MinKeepaliveTime)
+	}
+
+	if cfg.KeepaliveTimeout <= 0 {
+		return fmt.Errorf("the keepalive timeout should be positive")
+	}
+
+	if cfg.MaxBackoffDelay <= 0 {
+		return fmt.Errorf("the maximum backoff delay should be positive")
+	}
+
+	if cfg.RetryDelay <= 0 {
+		return fmt.Errorf("the retry delay should be positive")
+	}
+
+	return nil
+}
@@ -2,16 +2,29 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/avast/retry-go/v4"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+	"github.com/babylonlabs-io/finality-provider/rpcauth"
+	"github.com/babylonlabs-io/finality-provider/util"
 )
 
 var _ eotsmanager.EOTSManager = &EOTSManagerGRpcClient{}
@@ -19,10 +32,43 @@ var _ eotsmanager.EOTSManager = &EOTSManagerGRpcClient{}
 type EOTSManagerGRpcClient struct {
 	client proto.EOTSManagerClient
 	conn   *grpc.ClientConn
+	cfg    *Config
+	// quit stops watching the connectivity state once the client is closed
+	quit      chan struct{}
+	closeOnce sync.Once
 }
 
-func NewEOTSManagerGRpcClient(remoteAddr string) (*EOTSManagerGRpcClient, error) {
-	conn, err := grpc.Dial(remoteAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewEOTSManagerGRpcClient connects to the EOTS manager at the given address, authenticating
+// by the given config, which can be nil if the EOTS manager does not require authentication.
+// The connection is kept alive and re-established with backoff by the given connection config,
+// which uses the default one if nil
+func NewEOTSManagerGRpcClient(remoteAddr string, authCfg *rpcauth.ClientConfig, cfg *Config) (*EOTSManagerGRpcClient, error) {
+	if cfg == nil {
+		cfg = DefaultConfig()
+	}
+
+	dialOpts, err := authCfg.DialOptions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up the RPC authentication: %w", err)
+	}
+	dialOpts = append(dialOpts,
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.KeepaliveTime,
+			Timeout:             cfg.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  backoff.DefaultConfig.BaseDelay,
+				Multiplier: backoff.DefaultConfig.Multiplier,
+				Jitter:     backoff.DefaultConfig.Jitter,
+				MaxDelay:   cfg.MaxBackoffDelay,
+			},
+			MinConnectTimeout: cfg.RPCTimeout,
+		}),
+	)
+
+	conn, err := grpc.Dial(util.GRPCDialTarget(remoteAddr), dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build gRPC connection to %s: %w", remoteAddr, err)
 	}
@@ -30,29 +76,102 @@ func NewEOTSManagerGRpcClient(remoteAddr string) (*EOTSManagerGRpcClient, error)
 	gClient := &EOTSManagerGRpcClient{
 		client: proto.NewEOTSManagerClient(conn),
 		conn:   conn,
+		cfg:    cfg,
+		quit:   make(chan struct{}),
 	}
 
 	if err := gClient.Ping(); err != nil {
+		_ = conn.Close()
 		return nil, fmt.Errorf("the EOTS manager server is not responding: %w", err)
 	}
 
 	return gClient, nil
 }
 
+// callContext returns the context of an RPC, which is bounded by the configured deadline
+func (c *EOTSManagerGRpcClient) callContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.cfg.RPCTimeout)
+}
+
+// retryIdempotent calls the idempotent RPC, retrying with backoff while the EOTS manager
+// is unavailable, e.g., while it restarts
+func (c *EOTSManagerGRpcClient) retryIdempotent(call func() error) error {
+	return retry.Do(call,
+		retry.Attempts(c.cfg.MaxRetries+1),
+		retry.Delay(c.cfg.RetryDelay),
+		retry.MaxDelay(c.cfg.MaxBackoffDelay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.LastErrorOnly(true),
+		retry.RetryIf(func(err error) bool {
+			return status.Code(err) == codes.Unavailable
+		}),
+	)
+}
+
+// WatchConnectivity calls the observer with the connectivity state of the connection to the
+// EOTS manager, and again whenever it changes, until the client is closed
+func (c *EOTSManagerGRpcClient) WatchConnectivity(observer func(connectivity.State)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-c.quit
+		cancel()
+	}()
+
+	go func() {
+		state := c.conn.GetState()
+		observer(state)
+		for c.conn.WaitForStateChange(ctx, state) {
+			state = c.conn.GetState()
+			observer(state)
+		}
+	}()
+}
+
+// CheckConnectivity returns an error unless the connection to the EOTS manager is ready or idle
+func (c *EOTSManagerGRpcClient) CheckConnectivity() error {
+	switch state := c.conn.GetState(); state {
+	case connectivity.Ready, connectivity.Idle:
+		return nil
+	default:
+		return fmt.Errorf("the connection to the EOTS manager is %s", state)
+	}
+}
+
 func (c *EOTSManagerGRpcClient) Ping() error {
-	req := &proto.PingRequest{}
+	_, err := c.Info()
 
-	_, err := c.client.Ping(context.Background(), req)
-	if err != nil {
+	return err
+}
+
+// Info returns the version, randomness derivation scheme and capabilities of the EOTS manager,
+// where the version is empty if the EOTS manager predates the version handshake
+func (c *EOTSManagerGRpcClient) Info() (*types.ServerInfo, error) {
+	var res *proto.PingResponse
+	if err := c.retryIdempotent(func() error {
+		ctx, cancel := c.callContext()
+		defer cancel()
+
+		var err error
+		res, err = c.client.Ping(ctx, &proto.PingRequest{})
 		return err
+	}); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &types.ServerInfo{
+		Version:      res.Version,
+		Commit:       res.Commit,
+		RandScheme:   res.RandScheme,
+		Capabilities: res.Capabilities,
+	}, nil
 }
 
 func (c *EOTSManagerGRpcClient) CreateKey(name, passphrase, hdPath string) ([]byte, error) {
 	req := &proto.CreateKeyRequest{Name: name, Passphrase: passphrase, HdPath: hdPath}
-	res, err := c.client.CreateKey(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.client.CreateKey(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +179,10 @@ func (c *EOTSManagerGRpcClient) CreateKey(name, passphrase, hdPath string) ([]by
 	return res.Pk, nil
 }
 
+// CreateRandomnessPairList streams the public randomness from the EOTS manager in chunks
+// and reassembles them. The RPC timeout bounds the receipt of each chunk rather than the whole
+// stream, whose duration grows with num. It falls back to the unary call if the server does not
+// support streaming
 func (c *EOTSManagerGRpcClient) CreateRandomnessPairList(uid, chainID []byte, startHeight uint64, num uint32, passphrase string) ([]*btcec.FieldVal, error) {
 	req := &proto.CreateRandomnessPairListRequest{
 		Uid:         uid,
@@ -68,35 +191,95 @@ func (c *EOTSManagerGRpcClient) CreateRandomnessPairList(uid, chainID []byte, st
 		Num:         num,
 		Passphrase:  passphrase,
 	}
-	res, err := c.client.CreateRandomnessPairList(context.Background(), req)
+
+	var pubRandList []*btcec.FieldVal
+	if err := c.retryIdempotent(func() error {
+		var err error
+		pubRandList, err = c.createRandomnessPairListStream(req)
+		if status.Code(err) == codes.Unimplemented {
+			pubRandList, err = c.createRandomnessPairListUnary(req)
+		}
+		return err
+	}); err != nil {
+		return nil, fromStatusErr(err)
+	}
+
+	return pubRandList, nil
+}
+
+func (c *EOTSManagerGRpcClient) createRandomnessPairListStream(req *proto.CreateRandomnessPairListRequest) ([]*btcec.FieldVal, error) {
+	// the stream is cancelled if no chunk is received within the RPC timeout
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	timer := time.AfterFunc(c.cfg.RPCTimeout, cancel)
+	defer timer.Stop()
+
+	stream, err := c.client.CreateRandomnessPairListStream(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	pubRandFieldValList := make([]*btcec.FieldVal, 0, len(res.PubRandList))
-	for _, r := range res.PubRandList {
-		var fieldVal btcec.FieldVal
-		fieldVal.SetByteSlice(r)
-		pubRandFieldValList = append(pubRandFieldValList, &fieldVal)
+	pubRandFieldValList := make([]*btcec.FieldVal, 0, req.Num)
+	for {
+		timer.Reset(c.cfg.RPCTimeout)
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, status.Errorf(codes.DeadlineExceeded, "no randomness chunk received within %v", c.cfg.RPCTimeout)
+			}
+			return nil, err
+		}
+
+		expectedHeight := req.StartHeight + uint64(len(pubRandFieldValList))
+		if chunk.StartHeight != expectedHeight {
+			return nil, fmt.Errorf("unexpected randomness chunk at height %d, expected %d", chunk.StartHeight, expectedHeight)
+		}
+		pubRandFieldValList = append(pubRandFieldValList, toFieldValList(chunk.PubRandList)...)
+	}
+
+	if len(pubRandFieldValList) != int(req.Num) {
+		return nil, fmt.Errorf("received %d public randomness, expected %d", len(pubRandFieldValList), req.Num)
 	}
 
 	return pubRandFieldValList, nil
 }
 
-func (c *EOTSManagerGRpcClient) KeyRecord(uid []byte, passphrase string) (*types.KeyRecord, error) {
-	req := &proto.KeyRecordRequest{Uid: uid, Passphrase: passphrase}
+func (c *EOTSManagerGRpcClient) createRandomnessPairListUnary(req *proto.CreateRandomnessPairListRequest) ([]*btcec.FieldVal, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
 
-	res, err := c.client.KeyRecord(context.Background(), req)
+	res, err := c.client.CreateRandomnessPairList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	privKey, _ := btcec.PrivKeyFromBytes(res.PrivateKey)
+	if len(res.PubRandList) != int(req.Num) {
+		return nil, fmt.Errorf("received %d public randomness, expected %d", len(res.PubRandList), req.Num)
+	}
 
-	return &types.KeyRecord{
-		Name:    res.Name,
-		PrivKey: privKey,
-	}, nil
+	return toFieldValList(res.PubRandList), nil
+}
+
+func (c *EOTSManagerGRpcClient) KeyRecord(uid []byte, passphrase string) (*types.KeyRecord, error) {
+	req := &proto.KeyRecordRequest{Uid: uid, Passphrase: passphrase}
+
+	var res *proto.KeyRecordResponse
+	if err := c.retryIdempotent(func() error {
+		ctx, cancel := c.callContext()
+		defer cancel()
+
+		var err error
+		res, err = c.client.KeyRecord(ctx, req)
+		return err
+	}); err != nil {
+		return nil, fromStatusErr(err)
+	}
+
+	// the private key is not returned by the EOTS manager
+	return &types.KeyRecord{Name: res.Name}, nil
 }
 
 func (c *EOTSManagerGRpcClient) SignEOTS(uid, chaiID, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
@@ -107,9 +290,12 @@ func (c *EOTSManagerGRpcClient) SignEOTS(uid, chaiID, msg []byte, height uint64,
 		Height:     height,
 		Passphrase: passphrase,
 	}
-	res, err := c.client.SignEOTS(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.client.SignEOTS(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, fromStatusErr(err)
 	}
 
 	var s btcec.ModNScalar
@@ -118,13 +304,84 @@ func (c *EOTSManagerGRpcClient) SignEOTS(uid, chaiID, msg []byte, height uint64,
 	return &s, nil
 }
 
+func (c *EOTSManagerGRpcClient) SignEOTSBatch(uid, chainID []byte, reqs []*types.SignRequest, passphrase string) ([]*btcec.ModNScalar, error) {
+	msgs := make([]*proto.EOTSMsg, 0, len(reqs))
+	for _, r := range reqs {
+		msgs = append(msgs, &proto.EOTSMsg{Msg: r.Msg, Height: r.Height})
+	}
+
+	req := &proto.SignEOTSBatchRequest{
+		Uid:        uid,
+		ChainId:    chainID,
+		Msgs:       msgs,
+		Passphrase: passphrase,
+	}
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.client.SignEOTSBatch(ctx, req)
+	if err != nil {
+		return nil, fromStatusErr(err)
+	}
+
+	if len(res.Sigs) != len(reqs) {
+		return nil, fmt.Errorf("received %d EOTS signatures, expected %d", len(res.Sigs), len(reqs))
+	}
+
+	sigs := make([]*btcec.ModNScalar, 0, len(res.Sigs))
+	for _, sigBytes := range res.Sigs {
+		var s btcec.ModNScalar
+		s.SetByteSlice(sigBytes)
+		sigs = append(sigs, &s)
+	}
+
+	return sigs, nil
+}
+
 func (c *EOTSManagerGRpcClient) SignSchnorrSig(uid, msg []byte, passphrase string) (*schnorr.Signature, error) {
 	req := &proto.SignSchnorrSigRequest{Uid: uid, Msg: msg, Passphrase: passphrase}
-	res, err := c.client.SignSchnorrSig(context.Background(), req)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.client.SignSchnorrSig(ctx, req)
+	if err != nil {
+		return nil, fromStatusErr(err)
+	}
+
+	sig, err := schnorr.ParseSignature(res.Sig)
 	if err != nil {
 		return nil, err
 	}
 
+	return sig, nil
+}
+
+func (c *EOTSManagerGRpcClient) SignSchnorrSigForPayload(uid []byte, payload *types.SchnorrPayload, passphrase string) (*schnorr.Signature, error) {
+	req := &proto.SignSchnorrSigForPayloadRequest{Uid: uid, PopAddress: payload.PoPAddress, Passphrase: passphrase}
+	if payload.PubRandCommit != nil {
+		req.PubRandCommit = &proto.PubRandCommit{
+			StartHeight: payload.PubRandCommit.StartHeight,
+			NumPubRand:  payload.PubRandCommit.NumPubRand,
+			Commitment:  payload.PubRandCommit.Commitment,
+		}
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.client.SignSchnorrSigForPayload(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		// the EOTS manager predates recognised payloads, so sign the hash of the payload directly
+		msg, err := payload.HashToSign()
+		if err != nil {
+			return nil, fmt.Errorf("invalid Schnorr payload: %w", err)
+		}
+		return c.SignSchnorrSig(uid, msg, passphrase)
+	}
+	if err != nil {
+		return nil, fromStatusErr(err)
+	}
+
 	sig, err := schnorr.ParseSignature(res.Sig)
 	if err != nil {
 		return nil, err
@@ -133,6 +390,182 @@ func (c *EOTSManagerGRpcClient) SignSchnorrSig(uid, msg []byte, passphrase strin
 	return sig, nil
 }
 
+func (c *EOTSManagerGRpcClient) Unlock(uid []byte, passphrase string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("the unlock duration should be positive, got %v", ttl)
+	}
+
+	// round up so that a positive duration is never truncated to zero
+	ttlSeconds := uint64((ttl + time.Second - 1) / time.Second)
+	req := &proto.UnlockRequest{Uid: uid, Passphrase: passphrase, TtlSeconds: ttlSeconds}
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	_, err := c.client.Unlock(ctx, req)
+	if err != nil {
+		return fromStatusErr(err)
+	}
+
+	return nil
+}
+
+func (c *EOTSManagerGRpcClient) Lock(uid []byte) error {
+	req := &proto.LockRequest{Uid: uid}
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	_, err := c.client.Lock(ctx, req)
+	if err != nil {
+		return fromStatusErr(err)
+	}
+
+	return nil
+}
+
 func (c *EOTSManagerGRpcClient) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.quit)
+	})
+
 	return c.conn.Close()
 }
+
+func toFieldValList(pubRandList [][]byte) []*btcec.FieldVal {
+	pubRandFieldValList := make([]*btcec.FieldVal, 0, len(pubRandList))
+	for _, r := range pubRandList {
+		var fieldVal btcec.FieldVal
+		fieldVal.SetByteSlice(r)
+		pubRandFieldValList = append(pubRandFieldValList, &fieldVal)
+	}
+
+	return pubRandFieldValList
+}
+
+// ListAuditRecords returns at most limit audit records matching the filter starting from the
+// given page key, along with the key of the next page, which is zero if there is none
+func (c *EOTSManagerGRpcClient) ListAuditRecords(filter *types.AuditFilter, pageKey uint64, limit uint32) ([]*types.AuditRecord, uint64, error) {
+	req := &proto.ListAuditRecordsRequest{
+		ChainId:   []byte(filter.ChainID),
+		Operation: filter.Operation,
+		Outcome:   filter.Outcome,
+		PageKey:   pageKey,
+		Limit:     limit,
+	}
+	if filter.EOTSPkHex != "" {
+		pk, err := hex.DecodeString(filter.EOTSPkHex)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid EOTS public key %s: %w", filter.EOTSPkHex, err)
+		}
+		req.Uid = pk
+	}
+	if !filter.StartTime.IsZero() {
+		req.StartTime = filter.StartTime.Unix()
+	}
+	if !filter.EndTime.IsZero() {
+		req.EndTime = filter.EndTime.Unix()
+	}
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.client.ListAuditRecords(ctx, req)
+	if err != nil {
+		return nil, 0, fromStatusErr(err)
+	}
+
+	records := make([]*types.AuditRecord, 0, len(res.Records))
+	for _, r := range res.Records {
+		records = append(records, &types.AuditRecord{
+			ID:         r.Id,
+			Timestamp:  time.Unix(0, r.Timestamp).UTC(),
+			Operation:  r.Operation,
+			EOTSPkHex:  hex.EncodeToString(r.Uid),
+			KeyName:    r.KeyName,
+			ChainID:    string(r.ChainId),
+			Height:     r.Height,
+			NumPubRand: r.NumPubRand,
+			MsgHashHex: hex.EncodeToString(r.MsgHash),
+			Peer:       r.Peer,
+			Outcome:    r.Outcome,
+			Error:      r.Error,
+		})
+	}
+
+	return records, res.NextPageKey, nil
+}
+
+// Freeze makes the EOTS manager refuse to sign until it is unfrozen, and returns its freeze state
+func (c *EOTSManagerGRpcClient) Freeze(reason string) (*types.FreezeState, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.client.Freeze(ctx, &proto.FreezeRequest{Reason: reason})
+	if err != nil {
+		return nil, fromStatusErr(err)
+	}
+
+	return fromProtoFreezeState(res.State), nil
+}
+
+// Unfreeze lifts the freeze of the EOTS manager
+func (c *EOTSManagerGRpcClient) Unfreeze() error {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	_, err := c.client.Unfreeze(ctx, &proto.UnfreezeRequest{})
+	if err != nil {
+		return fromStatusErr(err)
+	}
+
+	return nil
+}
+
+// GetFreezeState returns the freeze state of the EOTS manager, which is nil if it is not frozen
+func (c *EOTSManagerGRpcClient) GetFreezeState() (*types.FreezeState, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	res, err := c.client.GetFreezeState(ctx, &proto.GetFreezeStateRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromProtoFreezeState(res.State), nil
+}
+
+func fromProtoFreezeState(state *proto.FreezeState) *types.FreezeState {
+	if state == nil {
+		return nil
+	}
+
+	return &types.FreezeState{
+		Reason:   state.Reason,
+		FrozenAt: time.Unix(state.FrozenAt, 0).UTC(),
+	}
+}
+
+// fromStatusErr recovers the signing and policy errors of the EOTS manager from gRPC status errors
+func fromStatusErr(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	var typedErrs []error
+	switch st.Code() {
+	case codes.FailedPrecondition:
+		typedErrs = types.SigningErrors
+	case codes.PermissionDenied:
+		typedErrs = types.PolicyErrors
+	default:
+		return err
+	}
+
+	for _, typedErr := range typedErrs {
+		if strings.HasPrefix(st.Message(), typedErr.Error()) {
+			return fmt.Errorf("%w%s", typedErr, strings.TrimPrefix(st.Message(), typedErr.Error()))
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,260 @@
+package client_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+)
+
+// stubServer answers Ping and blocks KeyRecord until the deadline of the call
+type stubServer struct {
+	proto.UnimplementedEOTSManagerServer
+}
+
+func (s *stubServer) Ping(context.Context, *proto.PingRequest) (*proto.PingResponse, error) {
+	return &proto.PingResponse{Version: "0.2.2"}, nil
+}
+
+func (s *stubServer) KeyRecord(ctx context.Context, _ *proto.KeyRecordRequest) (*proto.KeyRecordResponse, error) {
+	<-ctx.Done()
+	return nil, status.FromContextError(ctx.Err()).Err()
+}
+
+func serveStub(t *testing.T, addr string) *grpc.Server {
+	lis, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+
+	return serve(lis, &stubServer{})
+}
+
+func serve(lis net.Listener, srv proto.EOTSManagerServer) *grpc.Server {
+	s := grpc.NewServer()
+	proto.RegisterEOTSManagerServer(s, srv)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	return s
+}
+
+// randStubServer streams the public randomness in chunks of the given size, whose start
+// heights are shifted by heightShift, and drops the last numDropped public randomness.
+// Each chunk is sent after chunkDelay
+type randStubServer struct {
+	stubServer
+
+	chunkSize   int
+	heightShift uint64
+	numDropped  int
+	chunkDelay  time.Duration
+}
+
+func (s *randStubServer) CreateRandomnessPairListStream(
+	req *proto.CreateRandomnessPairListRequest,
+	stream proto.EOTSManager_CreateRandomnessPairListStreamServer,
+) error {
+	pubRandList := stubPubRandList(req.StartHeight, req.Num)
+	pubRandList = pubRandList[:len(pubRandList)-s.numDropped]
+
+	for start := 0; start < len(pubRandList); start += s.chunkSize {
+		end := start + s.chunkSize
+		if end > len(pubRandList) {
+			end = len(pubRandList)
+		}
+		time.Sleep(s.chunkDelay)
+		if err := stream.Send(&proto.CreateRandomnessPairListChunk{
+			StartHeight: req.StartHeight + uint64(start) + s.heightShift,
+			PubRandList: pubRandList[start:end],
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unaryRandStubServer only answers the unary CreateRandomnessPairList, like the
+// EOTS managers before the streaming was added, and drops the last numDropped
+// public randomness
+type unaryRandStubServer struct {
+	stubServer
+
+	numDropped int
+}
+
+func (s *unaryRandStubServer) CreateRandomnessPairList(
+	_ context.Context,
+	req *proto.CreateRandomnessPairListRequest,
+) (*proto.CreateRandomnessPairListResponse, error) {
+	pubRandList := stubPubRandList(req.StartHeight, req.Num)
+
+	return &proto.CreateRandomnessPairListResponse{
+		PubRandList: pubRandList[:len(pubRandList)-s.numDropped],
+	}, nil
+}
+
+// stubPubRandList returns the public randomness of the heights, which is the hash of each height
+func stubPubRandList(startHeight uint64, num uint32) [][]byte {
+	pubRandList := make([][]byte, 0, num)
+	for i := uint64(0); i < uint64(num); i++ {
+		h := sha256.Sum256(binary.BigEndian.AppendUint64(nil, startHeight+i))
+		pubRandList = append(pubRandList, h[:])
+	}
+
+	return pubRandList
+}
+
+// TestClientReconnect tests that the client reconnects to a restarted EOTS manager,
+// retrying the idempotent calls meanwhile, and bounds every call by the deadline
+func TestClientReconnect(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	server := serveStub(t, addr)
+
+	cfg := client.DefaultConfig()
+	cfg.RPCTimeout = time.Second
+	cfg.MaxBackoffDelay = 100 * time.Millisecond
+	cfg.MaxRetries = 20
+	cfg.RetryDelay = 50 * time.Millisecond
+	c, err := client.NewEOTSManagerGRpcClient(addr, nil, cfg)
+	require.NoError(t, err)
+	defer c.Close()
+
+	var mu sync.Mutex
+	var states []connectivity.State
+	c.WatchConnectivity(func(state connectivity.State) {
+		mu.Lock()
+		defer mu.Unlock()
+		states = append(states, state)
+	})
+
+	// the call is bounded by the deadline rather than hanging
+	_, err = c.KeyRecord([]byte("uid"), "")
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// restart the EOTS manager
+	server.Stop()
+	restarted := make(chan *grpc.Server, 1)
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		restarted <- serveStub(t, addr)
+	}()
+	require.NoError(t, c.Ping())
+	defer (<-restarted).Stop()
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(states) > 1 && states[len(states)-1] == connectivity.Ready
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+// newStubClient returns a client connected to the stub server, which are both
+// stopped at the end of the test
+func newStubClient(t *testing.T, srv proto.EOTSManagerServer, cfg *client.Config) *client.EOTSManagerGRpcClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := serve(lis, srv)
+	t.Cleanup(server.Stop)
+
+	c, err := client.NewEOTSManagerGRpcClient(lis.Addr().String(), nil, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = c.Close()
+	})
+
+	return c
+}
+
+// TestCreateRandomnessPairList tests reassembling the public randomness streamed in
+// chunks, bounding the receipt of each chunk by the RPC timeout, refusing the chunks
+// that do not add up to the request, and falling back to the unary call if the EOTS
+// manager does not support the streaming
+func TestCreateRandomnessPairList(t *testing.T) {
+	startHeight := uint64(100)
+	num := uint32(25)
+	expected := stubPubRandList(startHeight, num)
+
+	for _, tc := range []struct {
+		name       string
+		srv        proto.EOTSManagerServer
+		rpcTimeout time.Duration
+		errMsg     string
+	}{
+		{
+			name: "multiple chunks",
+			srv:  &randStubServer{chunkSize: 10},
+		},
+		{
+			name: "single chunk",
+			srv:  &randStubServer{chunkSize: int(num)},
+		},
+		{
+			name:   "start height mismatch",
+			srv:    &randStubServer{chunkSize: 10, heightShift: 1},
+			errMsg: fmt.Sprintf("unexpected randomness chunk at height %d, expected %d", startHeight+1, startHeight),
+		},
+		{
+			name:   "count mismatch",
+			srv:    &randStubServer{chunkSize: 10, numDropped: 1},
+			errMsg: fmt.Sprintf("received %d public randomness, expected %d", num-1, num),
+		},
+		{
+			name:       "stream longer than the timeout",
+			srv:        &randStubServer{chunkSize: 10, chunkDelay: 200 * time.Millisecond},
+			rpcTimeout: 500 * time.Millisecond,
+		},
+		{
+			name:       "chunk timeout",
+			srv:        &randStubServer{chunkSize: 10, chunkDelay: 500 * time.Millisecond},
+			rpcTimeout: 100 * time.Millisecond,
+			errMsg:     "rpc error: code = DeadlineExceeded desc = no randomness chunk received within 100ms",
+		},
+		{
+			name: "unary fallback",
+			srv:  &unaryRandStubServer{},
+		},
+		{
+			name:   "unary count mismatch",
+			srv:    &unaryRandStubServer{numDropped: 1},
+			errMsg: fmt.Sprintf("received %d public randomness, expected %d", num-1, num),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := client.DefaultConfig()
+			if tc.rpcTimeout != 0 {
+				cfg.RPCTimeout = tc.rpcTimeout
+			}
+			c := newStubClient(t, tc.srv, cfg)
+
+			pubRandList, err := c.CreateRandomnessPairList([]byte("uid"), []byte("chain-id"), startHeight, num, "")
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, pubRandList, int(num))
+			for i, pubRand := range pubRandList {
+				pubRandBytes := pubRand.Bytes()
+				require.Equal(t, expected[i], pubRandBytes[:])
+			}
+		})
+	}
+}
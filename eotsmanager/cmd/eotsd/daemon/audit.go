@@ -0,0 +1,119 @@
+package daemon
+
+import (
+	"fmt"
+	"time"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+const defaultAuditLimit = 100
+
+var AuditCommand = cli.Command{
+	Name:      "audit",
+	Usage:     "List the audit records of the running EOTS manager daemon.",
+	UsageText: "audit [flags]",
+	Description: `List the audit log of the running EOTS manager daemon, which records every
+	key creation, randomness creation and signing request it served, including the
+	rejected ones. The records are listed in the order they were appended, a page at a
+	time. The next page is listed by passing the returned next_page_key to --page-key.`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "Only list the records of the given EOTS public key",
+		},
+		cli.StringFlag{
+			Name:  chainIDFlag,
+			Usage: "Only list the records of the given chain ID",
+		},
+		cli.StringFlag{
+			Name: operationFlag,
+			Usage: fmt.Sprintf("Only list the records of the given operation, i.e., %s, %s, %s, %s, %s, %s, %s, %s or %s",
+				types.AuditOperationCreateKey, types.AuditOperationCreateRandomness,
+				types.AuditOperationSignEOTS, types.AuditOperationSignSchnorr,
+				types.AuditOperationKeyRecord, types.AuditOperationUnlock, types.AuditOperationLock,
+				types.AuditOperationFreeze, types.AuditOperationUnfreeze),
+		},
+		cli.StringFlag{
+			Name: outcomeFlag,
+			Usage: fmt.Sprintf("Only list the records of the given outcome, i.e., %s, %s or %s",
+				types.AuditOutcomeSuccess, types.AuditOutcomeRejected, types.AuditOutcomeFailed),
+		},
+		cli.StringFlag{
+			Name:  sinceFlag,
+			Usage: "Only list the records at or after the given time in RFC 3339, e.g., 2024-01-02T15:04:05Z",
+		},
+		cli.StringFlag{
+			Name:  untilFlag,
+			Usage: "Only list the records at or before the given time in RFC 3339, e.g., 2024-01-02T15:04:05Z",
+		},
+		cli.Uint64Flag{
+			Name:  pageKeyFlag,
+			Usage: "The key of the page to list, which is returned as next_page_key of the previous page",
+		},
+		cli.UintFlag{
+			Name:  limitFlag,
+			Usage: "The maximum number of records to list",
+			Value: defaultAuditLimit,
+		},
+	}, rpcClientFlags...),
+	Action: listAuditRecords,
+}
+
+type auditRecordsResponse struct {
+	Records     []*types.AuditRecord `json:"records"`
+	NextPageKey uint64               `json:"next_page_key,omitempty"`
+}
+
+func listAuditRecords(ctx *cli.Context) error {
+	filter := &types.AuditFilter{
+		ChainID:   ctx.String(chainIDFlag),
+		Operation: ctx.String(operationFlag),
+		Outcome:   ctx.String(outcomeFlag),
+	}
+
+	if eotsPkStr := ctx.String(eotsPkFlag); eotsPkStr != "" {
+		eotsPk, err := bbntypes.NewBIP340PubKeyFromHex(eotsPkStr)
+		if err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", eotsPkStr, err)
+		}
+		filter.EOTSPkHex = eotsPk.MarshalHex()
+	}
+
+	if since := ctx.String(sinceFlag); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return fmt.Errorf("invalid --%s time %s: %w", sinceFlag, since, err)
+		}
+		filter.StartTime = t
+	}
+
+	if until := ctx.String(untilFlag); until != "" {
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return fmt.Errorf("invalid --%s time %s: %w", untilFlag, until, err)
+		}
+		filter.EndTime = t
+	}
+
+	em, err := newEOTSManagerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer em.Close()
+
+	records, nextPageKey, err := em.ListAuditRecords(filter, ctx.Uint64(pageKeyFlag), uint32(ctx.Uint(limitFlag)))
+	if err != nil {
+		return fmt.Errorf("failed to list audit records: %w", err)
+	}
+
+	printRespJSON(&auditRecordsResponse{
+		Records:     records,
+		NextPageKey: nextPageKey,
+	})
+
+	return nil
+}
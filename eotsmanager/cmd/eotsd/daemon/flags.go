@@ -8,13 +8,34 @@ const (
 	rpcListenerFlag = "rpc-listener"
 	eotsPkFlag      = "eots-pk"
 	signatureFlag   = "signature"
+	hwmOnlyFlag     = "hwm-only"
+	rpcAddressFlag  = "rpc-address"
+	ttlFlag         = "ttl"
+	reasonFlag      = "reason"
+
+	// flags for authenticating to the RPC server
+	tlsCACertFlag = "tls-ca-cert"
+	tlsCertFlag   = "tls-cert"
+	tlsKeyFlag    = "tls-key"
+	tokenFileFlag = "token-file"
+
+	// flags for audit
+	chainIDFlag   = "chain-id"
+	operationFlag = "operation"
+	outcomeFlag   = "outcome"
+	sinceFlag     = "since"
+	untilFlag     = "until"
+	pageKeyFlag   = "page-key"
+	limitFlag     = "limit"
 
 	// flags for keys
-	keyNameFlag        = "key-name"
-	passphraseFlag     = "passphrase"
-	hdPathFlag         = "hd-path"
-	keyringBackendFlag = "keyring-backend"
-	recoverFlag        = "recover"
+	keyNameFlag         = "key-name"
+	passphraseFlag      = "passphrase"
+	hdPathFlag          = "hd-path"
+	keyringBackendFlag  = "keyring-backend"
+	recoverFlag         = "recover"
+	yesFlag             = "yes"
+	armorPassphraseFlag = "armor-passphrase"
 
 	defaultKeyringBackend = keyring.BackendTest
 	defaultHdPath         = ""
@@ -0,0 +1,99 @@
+package daemon
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+var FreezeCommand = cli.Command{
+	Name:      "freeze",
+	Usage:     "Freeze the running EOTS manager daemon so that it refuses to sign.",
+	UsageText: "freeze [flags]",
+	Description: `Freeze the running EOTS manager daemon, which then refuses every EOTS and
+	Schnorr signing request and wipes the unlocked keys from memory, while it keeps serving
+	the metrics and the audit log. The freeze is persisted in the database and survives
+	restarts until it is lifted by the unfreeze command.`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  reasonFlag,
+			Usage: "The reason of freezing the daemon, which is kept with the freeze",
+		},
+	}, rpcClientFlags...),
+	Action: freeze,
+}
+
+var UnfreezeCommand = cli.Command{
+	Name:        "unfreeze",
+	Usage:       "Lift the freeze of the running EOTS manager daemon.",
+	UsageText:   "unfreeze [flags]",
+	Description: `Lift the freeze of the running EOTS manager daemon so that it signs again.`,
+	Flags:       rpcClientFlags,
+	Action:      unfreeze,
+}
+
+var FreezeStatusCommand = cli.Command{
+	Name:        "freeze-status",
+	Usage:       "Show whether the running EOTS manager daemon is frozen.",
+	UsageText:   "freeze-status [flags]",
+	Description: `Show whether the running EOTS manager daemon is frozen, and since when and why if it is.`,
+	Flags:       rpcClientFlags,
+	Action:      freezeStatus,
+}
+
+type freezeStatusResponse struct {
+	Frozen bool               `json:"frozen"`
+	State  *types.FreezeState `json:"state,omitempty"`
+}
+
+func freeze(ctx *cli.Context) error {
+	em, err := newEOTSManagerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer em.Close()
+
+	state, err := em.Freeze(ctx.String(reasonFlag))
+	if err != nil {
+		return fmt.Errorf("failed to freeze the EOTS manager: %w", err)
+	}
+
+	printRespJSON(&freezeStatusResponse{Frozen: true, State: state})
+
+	return nil
+}
+
+func unfreeze(ctx *cli.Context) error {
+	em, err := newEOTSManagerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer em.Close()
+
+	if err := em.Unfreeze(); err != nil {
+		return fmt.Errorf("failed to unfreeze the EOTS manager: %w", err)
+	}
+
+	printRespJSON(&freezeStatusResponse{Frozen: false})
+
+	return nil
+}
+
+func freezeStatus(ctx *cli.Context) error {
+	em, err := newEOTSManagerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer em.Close()
+
+	state, err := em.GetFreezeState()
+	if err != nil {
+		return fmt.Errorf("failed to get the freeze state of the EOTS manager: %w", err)
+	}
+
+	printRespJSON(&freezeStatusResponse{Frozen: state != nil, State: state})
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 	"github.com/babylonlabs-io/finality-provider/log"
 )
 
@@ -30,6 +31,11 @@ var KeysCommands = []cli.Command{
 		Category: "Key management",
 		Subcommands: []cli.Command{
 			AddKeyCmd,
+			ListKeysCmd,
+			ShowKeyCmd,
+			DeleteKeyCmd,
+			ExportKeyCmd,
+			ImportKeyCmd,
 		},
 	},
 }
@@ -72,6 +78,176 @@ var AddKeyCmd = cli.Command{
 	Action: addKey,
 }
 
+var ListKeysCmd = cli.Command{
+	Name:  "list",
+	Usage: "List all the keys in the EOTS manager keyring.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+	},
+	Action: listKeys,
+}
+
+var ShowKeyCmd = cli.Command{
+	Name:  "show",
+	Usage: "Show a key in the EOTS manager keyring by its name or public key.",
+	Description: fmt.Sprintf(`Show the key associated with the %s or %s flag.
+	If the both flags are supplied, %s takes priority`, keyNameFlag, eotsPkFlag, eotsPkFlag),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key to show",
+		},
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "The public key of the key to show",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+	},
+	Action: showKey,
+}
+
+var DeleteKeyCmd = cli.Command{
+	Name:  "delete",
+	Usage: "Delete a key from the EOTS manager keyring by its name or public key.",
+	Description: fmt.Sprintf(`Delete the key associated with the %s or %s flag from both
+	the keyring and the EOTS database. If the both flags are supplied, %s takes priority.
+	The key cannot be recovered after deletion without its mnemonic or an exported copy`,
+		keyNameFlag, eotsPkFlag, eotsPkFlag),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key to delete",
+		},
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "The public key of the key to delete",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+		cli.BoolFlag{
+			Name:  yesFlag,
+			Usage: "Skip the confirmation prompt",
+		},
+	},
+	Action: deleteKey,
+}
+
+var ExportKeyCmd = cli.Command{
+	Name:      "export",
+	Usage:     "Export a key from the EOTS manager keyring as a passphrase-encrypted armored private key.",
+	UsageText: "keys export [file-path]",
+	Description: fmt.Sprintf(`Export the private key associated with the %s or %s flag in ASCII
+	armored format, encrypted by the passphrase given by the %s flag or prompted from stdin.
+	The armored key is printed if no file path is given`, keyNameFlag, eotsPkFlag, armorPassphraseFlag),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  keyNameFlag,
+			Usage: "The name of the key to export",
+		},
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "The public key of the key to export",
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		cli.StringFlag{
+			Name:  armorPassphraseFlag,
+			Usage: "The pass phrase used to encrypt the exported key",
+		},
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+	},
+	Action: exportKey,
+}
+
+var ImportKeyCmd = cli.Command{
+	Name:      "import",
+	Usage:     "Import a passphrase-encrypted armored private key into the EOTS manager keyring.",
+	UsageText: "keys import [file-path]",
+	Description: fmt.Sprintf(`Read the ASCII armored private key from the file received as argument,
+	decrypt it with the passphrase given by the %s flag or prompted from stdin, and save
+	it under the name given by the %s flag`, armorPassphraseFlag, keyNameFlag),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the keyring directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:     keyNameFlag,
+			Usage:    "The name of the key to be imported",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The pass phrase used to encrypt the keys",
+			Value: defaultPassphrase,
+		},
+		cli.StringFlag{
+			Name:  armorPassphraseFlag,
+			Usage: "The pass phrase used to decrypt the imported key",
+		},
+		cli.StringFlag{
+			Name:  keyringBackendFlag,
+			Usage: "The backend of the keyring",
+			Value: defaultKeyringBackend,
+		},
+	},
+	Action: importKey,
+}
+
 func addKey(ctx *cli.Context) error {
 	keyName := ctx.String(keyNameFlag)
 	keyringBackend := ctx.String(keyringBackendFlag)
@@ -165,3 +341,213 @@ func printRespJSONKeys(resp interface{}) {
 	fmt.Printf("New key for the BTC chain is created "+
 		"(mnemonic should be kept in a safe place for recovery):\n%s\n", jsonBytes)
 }
+
+func listKeys(ctx *cli.Context) error {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(homePath, ctx.String(keyringBackendFlag))
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	keys, err := eotsManager.ListKeys(ctx.String(passphraseFlag))
+	if err != nil {
+		return err
+	}
+
+	keyOutputs := make([]KeyOutput, 0, len(keys))
+	for _, k := range keys {
+		keyOutputs = append(keyOutputs, KeyOutput{
+			Name:      k.Name,
+			PubKeyHex: k.EOTSPk.MarshalHex(),
+		})
+	}
+
+	printRespJSON(keyOutputs)
+
+	return nil
+}
+
+func showKey(ctx *cli.Context) error {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(homePath, ctx.String(keyringBackendFlag))
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	keyInfo, err := getKeyInfo(ctx, eotsManager)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(KeyOutput{
+		Name:      keyInfo.Name,
+		PubKeyHex: keyInfo.EOTSPk.MarshalHex(),
+	})
+
+	return nil
+}
+
+func deleteKey(ctx *cli.Context) error {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(homePath, ctx.String(keyringBackendFlag))
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	keyInfo, err := getKeyInfo(ctx, eotsManager)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Bool(yesFlag) {
+		buf := bufio.NewReader(os.Stdin)
+		confirmed, err := input.GetConfirmation(
+			fmt.Sprintf("Key %s (%s) will be deleted. Continue?", keyInfo.Name, keyInfo.EOTSPk.MarshalHex()),
+			buf, os.Stderr,
+		)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	if _, err := eotsManager.DeleteKey(keyInfo.Name, ctx.String(passphraseFlag)); err != nil {
+		return fmt.Errorf("failed to delete key: %w", err)
+	}
+
+	printRespJSON(KeyOutput{
+		Name:      keyInfo.Name,
+		PubKeyHex: keyInfo.EOTSPk.MarshalHex(),
+	})
+
+	return nil
+}
+
+func exportKey(ctx *cli.Context) error {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(homePath, ctx.String(keyringBackendFlag))
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	keyInfo, err := getKeyInfo(ctx, eotsManager)
+	if err != nil {
+		return err
+	}
+
+	armorPassphrase, err := getArmorPassphrase(ctx, "Enter passphrase to encrypt the exported key:")
+	if err != nil {
+		return err
+	}
+
+	armor, err := eotsManager.ExportKeyArmor(keyInfo.Name, ctx.String(passphraseFlag), armorPassphrase)
+	if err != nil {
+		return err
+	}
+
+	outputFilePath := ctx.Args().First()
+	if outputFilePath == "" {
+		fmt.Println(armor)
+		return nil
+	}
+
+	if err := os.WriteFile(outputFilePath, []byte(armor), 0600); err != nil {
+		return fmt.Errorf("failed to write the armored key to %s: %w", outputFilePath, err)
+	}
+
+	return nil
+}
+
+func importKey(ctx *cli.Context) error {
+	inputFilePath := ctx.Args().First()
+	if inputFilePath == "" {
+		return errors.New("invalid argument, please provide a valid file path as input argument")
+	}
+
+	// #nosec G304 - The file path is provided by the user and not externally
+	armor, err := os.ReadFile(inputFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read the armored key from %s: %w", inputFilePath, err)
+	}
+
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(homePath, ctx.String(keyringBackendFlag))
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	armorPassphrase, err := getArmorPassphrase(ctx, "Enter passphrase to decrypt the imported key:")
+	if err != nil {
+		return err
+	}
+
+	keyName := ctx.String(keyNameFlag)
+	eotsPk, err := eotsManager.ImportKeyArmor(keyName, ctx.String(passphraseFlag), string(armor), armorPassphrase)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(KeyOutput{
+		Name:      keyName,
+		PubKeyHex: eotsPk.MarshalHex(),
+	})
+
+	return nil
+}
+
+// getKeyInfo returns the key by the eots-pk flag if it is set, or by the key-name flag otherwise
+func getKeyInfo(ctx *cli.Context, eotsManager *eotsmanager.LocalEOTSManager) (*types.KeyInfo, error) {
+	keyName := ctx.String(keyNameFlag)
+	fpPkStr := ctx.String(eotsPkFlag)
+	passphrase := ctx.String(passphraseFlag)
+
+	if len(fpPkStr) > 0 {
+		fpPk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EOTS public key %s: %w", fpPkStr, err)
+		}
+		return eotsManager.KeyInfoByPk(*fpPk, passphrase)
+	}
+
+	if len(keyName) > 0 {
+		return eotsManager.KeyInfo(keyName, passphrase)
+	}
+
+	return nil, fmt.Errorf("at least one of the flags: %s, %s needs to be informed", keyNameFlag, eotsPkFlag)
+}
+
+func getArmorPassphrase(ctx *cli.Context, prompt string) (string, error) {
+	if ctx.IsSet(armorPassphraseFlag) {
+		return ctx.String(armorPassphraseFlag), nil
+	}
+
+	buf := bufio.NewReader(os.Stdin)
+	return input.GetPassword(prompt, buf)
+}
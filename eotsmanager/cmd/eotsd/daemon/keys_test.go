@@ -0,0 +1,89 @@
+package daemon_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dcli "github.com/babylonlabs-io/finality-provider/eotsmanager/cmd/eotsd/daemon"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzKeysCommands tests listing, showing, exporting, deleting and importing keys
+func FuzzKeysCommands(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tempDir := t.TempDir()
+		homeDir := filepath.Join(tempDir, "eots-home")
+		app := testApp()
+
+		hFlag := fmt.Sprintf("--home=%s", homeDir)
+		err := app.Run([]string{"eotsd", "init", hFlag})
+		require.NoError(t, err)
+
+		keyName := testutil.GenRandomHexStr(r, 10)
+		keyNameFlag := fmt.Sprintf("--key-name=%s", keyName)
+
+		outputKeysAdd := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "add", hFlag, keyNameFlag})
+		var keyOut dcli.KeyOutput
+		err = json.Unmarshal([]byte(searchInTxt(outputKeysAdd, "for recovery):")), &keyOut)
+		require.NoError(t, err)
+		eotsPkFlag := fmt.Sprintf("--eots-pk=%s", keyOut.PubKeyHex)
+
+		// list
+		outputList := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "list", hFlag})
+		var keysList []dcli.KeyOutput
+		err = json.Unmarshal([]byte(searchInTxt(outputList, "")), &keysList)
+		require.NoError(t, err)
+		require.Equal(t, []dcli.KeyOutput{{Name: keyName, PubKeyHex: keyOut.PubKeyHex}}, keysList)
+
+		// show by name and by pk
+		for _, flag := range []string{keyNameFlag, eotsPkFlag} {
+			outputShow := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "show", hFlag, flag})
+			var keyShow dcli.KeyOutput
+			err = json.Unmarshal([]byte(searchInTxt(outputShow, "")), &keyShow)
+			require.NoError(t, err)
+			require.Equal(t, keyName, keyShow.Name)
+			require.Equal(t, keyOut.PubKeyHex, keyShow.PubKeyHex)
+		}
+
+		// export
+		armorPath := filepath.Join(tempDir, "key.armor")
+		armorPassphraseFlag := fmt.Sprintf("--armor-passphrase=%s", testutil.GenRandomHexStr(r, 8))
+		err = app.Run([]string{"eotsd", "keys", "export", armorPath, hFlag, eotsPkFlag, armorPassphraseFlag})
+		require.NoError(t, err)
+
+		// delete
+		err = app.Run([]string{"eotsd", "keys", "delete", hFlag, keyNameFlag, "--yes"})
+		require.NoError(t, err)
+		err = app.Run([]string{"eotsd", "keys", "show", hFlag, keyNameFlag})
+		require.Error(t, err)
+		err = app.Run([]string{"eotsd", "keys", "show", hFlag, eotsPkFlag})
+		require.Error(t, err)
+
+		// import under a new name
+		newKeyName := testutil.GenRandomHexStr(r, 11)
+		newKeyNameFlag := fmt.Sprintf("--key-name=%s", newKeyName)
+		err = app.Run([]string{"eotsd", "keys", "import", armorPath, hFlag, newKeyNameFlag, "--armor-passphrase=wrong"})
+		require.Error(t, err)
+		outputImport := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "import", armorPath, hFlag, newKeyNameFlag, armorPassphraseFlag})
+		var keyImported dcli.KeyOutput
+		// skip the log line of the import
+		err = json.Unmarshal([]byte(searchInTxt(outputImport, "}\n")), &keyImported)
+		require.NoError(t, err)
+		require.Equal(t, newKeyName, keyImported.Name)
+		require.Equal(t, keyOut.PubKeyHex, keyImported.PubKeyHex)
+
+		outputShow := appRunWithOutput(r, t, app, []string{"eotsd", "keys", "show", hFlag, eotsPkFlag})
+		var keyShow dcli.KeyOutput
+		err = json.Unmarshal([]byte(searchInTxt(outputShow, "")), &keyShow)
+		require.NoError(t, err)
+		require.Equal(t, newKeyName, keyShow.Name)
+	})
+}
@@ -0,0 +1,48 @@
+package daemon
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+	"github.com/babylonlabs-io/finality-provider/rpcauth"
+)
+
+// rpcClientFlags are the flags of connecting to the RPC server of the EOTS manager daemon
+var rpcClientFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  rpcAddressFlag,
+		Usage: "The RPC address of the EOTS manager daemon",
+		Value: defaultRpcAddress,
+	},
+	cli.StringFlag{
+		Name:  tlsCACertFlag,
+		Usage: "The path of the CA certificates verifying the certificate of the daemon; empty to connect without TLS",
+	},
+	cli.StringFlag{
+		Name:  tlsCertFlag,
+		Usage: "The path of the TLS client certificate",
+	},
+	cli.StringFlag{
+		Name:  tlsKeyFlag,
+		Usage: "The path of the TLS client private key",
+	},
+	cli.StringFlag{
+		Name:  tokenFileFlag,
+		Usage: "The path of the file of the bearer token sent to the daemon",
+	},
+}
+
+// newEOTSManagerClient connects to the EOTS manager daemon by the rpcClientFlags
+func newEOTSManagerClient(ctx *cli.Context) (*client.EOTSManagerGRpcClient, error) {
+	authCfg := &rpcauth.ClientConfig{
+		TLSCACertPath: ctx.String(tlsCACertFlag),
+		TLSCertPath:   ctx.String(tlsCertFlag),
+		TLSKeyPath:    ctx.String(tlsKeyFlag),
+		TokenFile:     ctx.String(tokenFileFlag),
+	}
+	if err := authCfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return client.NewEOTSManagerGRpcClient(ctx.String(rpcAddressFlag), authCfg, nil)
+}
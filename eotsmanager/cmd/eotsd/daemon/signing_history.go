@@ -0,0 +1,151 @@
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+var SigningHistoryCommands = []cli.Command{
+	{
+		Name:     "signing-history",
+		Usage:    "Command sets of exporting and importing the EOTS signing history.",
+		Category: "Signing history",
+		Subcommands: []cli.Command{
+			ExportSigningHistoryCmd,
+			ImportSigningHistoryCmd,
+		},
+	},
+}
+
+var ExportSigningHistoryCmd = cli.Command{
+	Name:      "export",
+	Usage:     "Export the EOTS signing history to a JSON file.",
+	UsageText: "signing-history export [file-path]",
+	Description: `Export the heights signed by the EOTS keys on each chain, with the message
+	hashes and a high-water mark per chain, in a versioned JSON interchange format. The
+	output is printed if no file path is given. The EOTS manager daemon should be stopped
+	before exporting, so that no more signatures are produced after the export.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.StringFlag{
+			Name:  eotsPkFlag,
+			Usage: "Only export the signing history of the given EOTS public key",
+		},
+		cli.BoolFlag{
+			Name:  hwmOnlyFlag,
+			Usage: "Only export the high-water mark of each chain without the signed heights",
+		},
+	},
+	Action: exportSigningHistory,
+}
+
+var ImportSigningHistoryCmd = cli.Command{
+	Name:      "import",
+	Usage:     "Import the EOTS signing history from a JSON file.",
+	UsageText: "signing-history import [file-path]",
+	Description: `Merge the signing history exported by another EOTS manager into the EOTS
+	database. Existing signing records are never overwritten, and the EOTS manager will
+	refuse to sign new messages at or below the imported heights of each chain.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the eotsd home directory",
+			Value: config.DefaultEOTSDir,
+		},
+	},
+	Action: importSigningHistory,
+}
+
+func exportSigningHistory(ctx *cli.Context) error {
+	var fpPk []byte
+	if fpPkStr := ctx.String(eotsPkFlag); fpPkStr != "" {
+		pk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
+		if err != nil {
+			return fmt.Errorf("invalid EOTS public key %s: %w", fpPkStr, err)
+		}
+		fpPk = pk.MustMarshal()
+	}
+
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(homePath, "")
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	history, err := eotsManager.ExportSigningHistory(fpPk, ctx.Bool(hwmOnlyFlag))
+	if err != nil {
+		return fmt.Errorf("failed to export signing history: %w", err)
+	}
+
+	outputFilePath := ctx.Args().First()
+	if outputFilePath == "" {
+		printRespJSON(history)
+		return nil
+	}
+
+	bz, err := json.MarshalIndent(history, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(outputFilePath, bz, 0600); err != nil {
+		return fmt.Errorf("failed to write signing history to %s: %w", outputFilePath, err)
+	}
+
+	return nil
+}
+
+func importSigningHistory(ctx *cli.Context) error {
+	inputFilePath := ctx.Args().First()
+	if inputFilePath == "" {
+		return errors.New("invalid argument, please provide a valid file path as input argument")
+	}
+
+	// #nosec G304 - The file path is provided by the user and not externally
+	bz, err := os.ReadFile(inputFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read signing history from %s: %w", inputFilePath, err)
+	}
+
+	var history types.SigningHistory
+	if err := json.Unmarshal(bz, &history); err != nil {
+		return fmt.Errorf("failed to decode signing history: %w", err)
+	}
+
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	eotsManager, dbBackend, err := loadLocalEOTSManager(homePath, "")
+	if err != nil {
+		return err
+	}
+	defer dbBackend.Close()
+
+	results, err := eotsManager.ImportSigningHistory(&history)
+	if err != nil {
+		return fmt.Errorf("failed to import signing history: %w", err)
+	}
+
+	printRespJSON(results)
+
+	return nil
+}
@@ -2,9 +2,9 @@ package daemon
 
 import (
 	"fmt"
-	"net"
 	"path/filepath"
 
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/urfave/cli"
 
@@ -46,8 +46,7 @@ func startFn(ctx *cli.Context) error {
 
 	rpcListener := ctx.String(rpcListenerFlag)
 	if rpcListener != "" {
-		_, err := net.ResolveTCPAddr("tcp", rpcListener)
-		if err != nil {
+		if err := util.ValidateListenAddr(rpcListener); err != nil {
 			return fmt.Errorf("invalid RPC listener address %s, %w", rpcListener, err)
 		}
 		cfg.RpcListener = rpcListener
@@ -68,6 +67,12 @@ func startFn(ctx *cli.Context) error {
 		return fmt.Errorf("failed to create EOTS manager: %w", err)
 	}
 
+	if cfg.Policy != nil {
+		if err := eotsManager.SetSigningPolicy(cfg.Policy); err != nil {
+			return fmt.Errorf("failed to set the signing policy: %w", err)
+		}
+	}
+
 	// Hook interceptor for os signals.
 	shutdownInterceptor, err := signal.Intercept()
 	if err != nil {
@@ -86,3 +91,35 @@ func getHomeFlag(ctx *cli.Context) (string, error) {
 	}
 	return util.CleanAndExpandPath(homePath), nil
 }
+
+// loadLocalEOTSManager opens the db and the keyring under the given home path
+// and returns the EOTS manager built on top of them. The returned db backend
+// should be closed by the caller
+func loadLocalEOTSManager(homePath, keyringBackend string) (*eotsmanager.LocalEOTSManager, kvdb.Backend, error) {
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	if keyringBackend == "" {
+		keyringBackend = cfg.KeyringBackend
+	}
+
+	logger, err := log.NewRootLoggerWithFile(config.LogFile(homePath), cfg.LogLevel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load the logger")
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+
+	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, keyringBackend, dbBackend, logger)
+	if err != nil {
+		dbBackend.Close()
+		return nil, nil, fmt.Errorf("failed to create EOTS manager: %w", err)
+	}
+
+	return eotsManager, dbBackend, nil
+}
@@ -0,0 +1,103 @@
+package daemon
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/urfave/cli"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+)
+
+var (
+	defaultRpcAddress = "127.0.0.1:" + strconv.Itoa(config.DefaultRPCPort)
+	defaultUnlockTTL  = 24 * time.Hour
+)
+
+var UnlockCommand = cli.Command{
+	Name:      "unlock",
+	Usage:     "Unlock an EOTS key in the running EOTS manager daemon.",
+	UsageText: fmt.Sprintf("unlock --%s [eots-pk]", eotsPkFlag),
+	Description: `Decrypt the EOTS key in the running EOTS manager daemon and keep it in
+	memory for the given duration. While the key is unlocked, the daemon signs with it
+	without requiring the passphrase in the requests. The key is wiped from memory when
+	it expires, when it is locked, or when the daemon stops.`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:     eotsPkFlag,
+			Usage:    "The EOTS public key to unlock",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  passphraseFlag,
+			Usage: "The passphrase used to decrypt the keyring",
+			Value: defaultPassphrase,
+		},
+		cli.DurationFlag{
+			Name:  ttlFlag,
+			Usage: "The duration for which the key stays unlocked",
+			Value: defaultUnlockTTL,
+		},
+	}, rpcClientFlags...),
+	Action: unlock,
+}
+
+var LockCommand = cli.Command{
+	Name:        "lock",
+	Usage:       "Lock an unlocked EOTS key in the running EOTS manager daemon.",
+	UsageText:   fmt.Sprintf("lock --%s [eots-pk]", eotsPkFlag),
+	Description: `Wipe the unlocked EOTS key from the memory of the running EOTS manager daemon.`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:     eotsPkFlag,
+			Usage:    "The EOTS public key to lock",
+			Required: true,
+		},
+	}, rpcClientFlags...),
+	Action: lock,
+}
+
+func unlock(ctx *cli.Context) error {
+	eotsPk, err := bbntypes.NewBIP340PubKeyFromHex(ctx.String(eotsPkFlag))
+	if err != nil {
+		return fmt.Errorf("invalid EOTS public key: %w", err)
+	}
+
+	em, err := newEOTSManagerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer em.Close()
+
+	ttl := ctx.Duration(ttlFlag)
+	if err := em.Unlock(eotsPk.MustMarshal(), ctx.String(passphraseFlag), ttl); err != nil {
+		return fmt.Errorf("failed to unlock EOTS key %s: %w", eotsPk.MarshalHex(), err)
+	}
+
+	fmt.Printf("EOTS key %s is unlocked for %v\n", eotsPk.MarshalHex(), ttl)
+
+	return nil
+}
+
+func lock(ctx *cli.Context) error {
+	eotsPk, err := bbntypes.NewBIP340PubKeyFromHex(ctx.String(eotsPkFlag))
+	if err != nil {
+		return fmt.Errorf("invalid EOTS public key: %w", err)
+	}
+
+	em, err := newEOTSManagerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer em.Close()
+
+	if err := em.Lock(eotsPk.MustMarshal()); err != nil {
+		return fmt.Errorf("failed to lock EOTS key %s: %w", eotsPk.MarshalHex(), err)
+	}
+
+	fmt.Printf("EOTS key %s is locked\n", eotsPk.MarshalHex())
+
+	return nil
+}
@@ -2,15 +2,17 @@ package config
 
 import (
 	"fmt"
-	"net"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/jessevdk/go-flags"
 
+	"github.com/babylonlabs-io/finality-provider/gateway"
 	"github.com/babylonlabs-io/finality-provider/metrics"
+	"github.com/babylonlabs-io/finality-provider/rpcauth"
 	"github.com/babylonlabs-io/finality-provider/util"
 )
 
@@ -22,6 +24,11 @@ const (
 	defaultConfigFileName = "eotsd.conf"
 	DefaultRPCPort        = 12582
 	defaultKeyringBackend = keyring.BackendTest
+	defaultAuditRetention = 90 * 24 * time.Hour
+
+	// MinKeepaliveTime is the minimum interval of the keepalive pings the RPC server permits,
+	// below which it closes the connections of the clients pinging too often
+	MinKeepaliveTime = 10 * time.Second
 )
 
 var (
@@ -38,9 +45,19 @@ type Config struct {
 	LogLevel       string          `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
 	KeyringBackend string          `long:"keyring-type" description:"Type of keyring to use"`
 	RpcListener    string          `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
+	AuditRetention time.Duration   `long:"auditretention" description:"The duration for which the audit records are kept, 0 to keep them forever"`
+	FreezeFile     string          `long:"freezefile" description:"The path of a file whose presence freezes the EOTS manager, empty to disable"`
 	Metrics        *metrics.Config `group:"metrics" namespace:"metrics"`
 
 	DatabaseConfig *DBConfig `group:"dbconfig" namespace:"dbconfig"`
+
+	Policy *PolicyConfig `group:"policy" namespace:"policy"`
+
+	RPCAuth *rpcauth.ServerConfig `group:"rpcauth" namespace:"rpcauth"`
+
+	RPCSocket *util.UnixSocketConfig `group:"rpcsocket" namespace:"rpcsocket"`
+
+	Gateway *gateway.Config `group:"gateway" namespace:"gateway"`
 }
 
 // LoadConfig initializes and parses the config using a config file and command
@@ -80,8 +97,7 @@ func LoadConfig(homePath string) (*Config, error) {
 // illegal values or combination of values are set. All file system paths are
 // normalized. The cleaned up config is returned on success.
 func (cfg *Config) Validate() error {
-	_, err := net.ResolveTCPAddr("tcp", cfg.RpcListener)
-	if err != nil {
+	if err := util.ValidateListenAddr(cfg.RpcListener); err != nil {
 		return fmt.Errorf("invalid RPC listener address %s, %w", cfg.RpcListener, err)
 	}
 
@@ -89,6 +105,10 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("the keyring backend should not be empty")
 	}
 
+	if cfg.AuditRetention < 0 {
+		return fmt.Errorf("the audit retention should not be negative")
+	}
+
 	if cfg.Metrics == nil {
 		return fmt.Errorf("empty metrics config")
 	}
@@ -97,6 +117,34 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid metrics config")
 	}
 
+	// the policy is optional for the config files written before it was introduced
+	if cfg.Policy != nil {
+		if err := cfg.Policy.Validate(); err != nil {
+			return fmt.Errorf("invalid signing policy: %w", err)
+		}
+	}
+
+	// the authentication is optional for the config files written before it was introduced
+	if cfg.RPCAuth != nil {
+		if err := cfg.RPCAuth.Validate(); err != nil {
+			return fmt.Errorf("invalid RPC authentication config: %w", err)
+		}
+	}
+
+	// the unix socket config is optional for the config files written before it was introduced
+	if cfg.RPCSocket != nil {
+		if err := cfg.RPCSocket.Validate(); err != nil {
+			return fmt.Errorf("invalid RPC unix socket config: %w", err)
+		}
+	}
+
+	// the gateway config is optional for the config files written before it was introduced
+	if cfg.Gateway != nil {
+		if err := cfg.Gateway.Validate(); err != nil {
+			return fmt.Errorf("invalid REST gateway config: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -126,7 +174,12 @@ func DefaultConfigWithHomePath(homePath string) *Config {
 		KeyringBackend: defaultKeyringBackend,
 		DatabaseConfig: DefaultDBConfigWithHomePath(homePath),
 		RpcListener:    defaultRpcListener,
+		AuditRetention: defaultAuditRetention,
 		Metrics:        metrics.DefaultEotsConfig(),
+		Policy:         DefaultPolicyConfig(),
+		RPCAuth:        rpcauth.DefaultServerConfig(),
+		RPCSocket:      util.DefaultUnixSocketConfig(),
+		Gateway:        gateway.DefaultConfig(),
 	}
 	if err := cfg.Validate(); err != nil {
 		panic(err)
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// PolicyAllKeys is the key of the policy rules applied to the EOTS keys without their own rules
+const PolicyAllKeys = "*"
+
+// PolicyConfig is the signing policy of the EOTS keys. Each rule maps the hex of an EOTS public
+// key, or "*" for the keys without their own rule, to its value, e.g., <eots-pk-hex>:1000
+type PolicyConfig struct {
+	AllowedChainIDs map[string]string `long:"allowedchainids" description:"The comma separated chain IDs on which the key is allowed to sign EOTS, e.g., <eots-pk-hex>:chain-a,chain-b"`
+	MaxHeightJump   map[string]uint64 `long:"maxheightjump" description:"The maximum height above the last signed height of the chain at which the key is allowed to sign EOTS, e.g., <eots-pk-hex>:1000"`
+	HeightFloor     map[string]uint64 `long:"heightfloor" description:"The height below which the key is not allowed to sign EOTS, e.g., <eots-pk-hex>:100"`
+	RestrictSchnorr map[string]bool   `long:"restrictschnorr" description:"Whether the key only signs Schnorr signatures over recognised payloads, i.e., public randomness commits and proofs of possession, e.g., *:true"`
+}
+
+func DefaultPolicyConfig() *PolicyConfig {
+	return &PolicyConfig{
+		AllowedChainIDs: map[string]string{},
+		MaxHeightJump:   map[string]uint64{},
+		HeightFloor:     map[string]uint64{},
+		RestrictSchnorr: map[string]bool{},
+	}
+}
+
+func (cfg *PolicyConfig) Validate() error {
+	for k, v := range cfg.AllowedChainIDs {
+		if err := validatePolicyKey(k); err != nil {
+			return err
+		}
+		for _, chainID := range strings.Split(v, ",") {
+			if strings.TrimSpace(chainID) == "" {
+				return fmt.Errorf("empty chain ID in the allowed chain IDs of %s", k)
+			}
+		}
+	}
+
+	for k := range cfg.MaxHeightJump {
+		if err := validatePolicyKey(k); err != nil {
+			return err
+		}
+	}
+
+	for k := range cfg.HeightFloor {
+		if err := validatePolicyKey(k); err != nil {
+			return err
+		}
+	}
+
+	for k := range cfg.RestrictSchnorr {
+		if err := validatePolicyKey(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validatePolicyKey(k string) error {
+	if k == PolicyAllKeys {
+		return nil
+	}
+
+	pk, err := hex.DecodeString(k)
+	if err != nil || len(pk) != 32 {
+		return fmt.Errorf("invalid EOTS public key %s in the signing policy", k)
+	}
+
+	return nil
+}
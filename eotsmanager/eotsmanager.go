@@ -1,6 +1,8 @@
 package eotsmanager
 
 import (
+	"time"
+
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 
@@ -22,6 +24,7 @@ type EOTSManager interface {
 	CreateRandomnessPairList(uid []byte, chainID []byte, startHeight uint64, num uint32, passphrase string) ([]*btcec.FieldVal, error)
 
 	// KeyRecord returns the finality provider record
+	// The private key is only returned by the LocalEOTSManager, not by the RPC client
 	// It fails if the finality provider does not exist or passPhrase is incorrect
 	KeyRecord(uid []byte, passphrase string) (*types.KeyRecord, error)
 
@@ -31,10 +34,30 @@ type EOTSManager interface {
 	// or passPhrase is incorrect
 	SignEOTS(uid []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error)
 
+	// SignEOTSBatch signs a list of messages at distinct heights of the given chain, unlocking
+	// the private key of the finality provider once. The signatures are returned in the order
+	// of the requests
+	// It fails without signing any message if any of the messages cannot be signed
+	SignEOTSBatch(uid []byte, chainID []byte, reqs []*types.SignRequest, passphrase string) ([]*btcec.ModNScalar, error)
+
 	// SignSchnorrSig signs a Schnorr signature using the private key of the finality provider
 	// It fails if the finality provider does not exist or the message size is not 32 bytes
 	// or passPhrase is incorrect
 	SignSchnorrSig(uid []byte, msg []byte, passphrase string) (*schnorr.Signature, error)
 
+	// SignSchnorrSigForPayload signs a Schnorr signature over the hash of the given payload
+	// using the private key of the finality provider
+	// It fails if the finality provider does not exist or the payload is invalid
+	// or passPhrase is incorrect
+	SignSchnorrSigForPayload(uid []byte, payload *types.SchnorrPayload, passphrase string) (*schnorr.Signature, error)
+
+	// Unlock decrypts the private key of the finality provider and keeps it in memory for
+	// the given duration, during which the key signs without the passphrase
+	// It fails if the finality provider does not exist or passPhrase is incorrect
+	Unlock(uid []byte, passphrase string, ttl time.Duration) error
+
+	// Lock wipes the unlocked private key of the finality provider from memory
+	Lock(uid []byte) error
+
 	Close() error
 }
@@ -0,0 +1,18 @@
+package eotsmanager
+
+import (
+	"github.com/babylonlabs-io/finality-provider/health"
+)
+
+// CheckKeyring checks the keyring of the EOTS manager can be read without unlocking it
+func (lm *LocalEOTSManager) CheckKeyring() error {
+	return health.KeyringCheck(lm.homeDir, lm.keyringBackend)()
+}
+
+// CheckSigning returns ErrFrozen if the EOTS manager is frozen and refuses to sign
+func (lm *LocalEOTSManager) CheckSigning() error {
+	lm.freezeMu.RLock()
+	defer lm.freezeMu.RUnlock()
+
+	return lm.checkFrozen()
+}
@@ -0,0 +1,17 @@
+package eotsmanager
+
+import (
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/randgenerator"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+	"github.com/babylonlabs-io/finality-provider/version"
+)
+
+// Info returns the version, randomness derivation scheme and capabilities of the EOTS manager
+func (lm *LocalEOTSManager) Info() *types.ServerInfo {
+	return &types.ServerInfo{
+		Version:      version.SemanticVersion(),
+		Commit:       version.Commit,
+		RandScheme:   randgenerator.SchemeID,
+		Capabilities: append([]string(nil), types.Capabilities...),
+	}
+}
@@ -1,7 +1,10 @@
 package eotsmanager
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -12,6 +15,7 @@ import (
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/go-bip39"
@@ -39,6 +43,23 @@ type LocalEOTSManager struct {
 	// input is to send passphrase to kr
 	input   *strings.Reader
 	metrics *metrics.EotsMetrics
+	// homeDir and keyringBackend are kept to open fresh keyrings for decryption
+	homeDir        string
+	keyringBackend string
+
+	unlockMu sync.Mutex
+	// unlockedKeys are the decrypted EOTS private keys indexed by the hex of public keys
+	unlockedKeys map[string]*unlockedKey
+
+	policyMu sync.RWMutex
+	// policy is the signing policy enforced on the keys, nil if there is none
+	policy *signingPolicy
+
+	// freezeMu is held for reading while signing, so that freezing waits for the
+	// signing in progress
+	freezeMu sync.RWMutex
+	// frozen is the freeze state of the manager, nil if it is not frozen
+	frozen *eotstypes.FreezeState
 }
 
 func NewLocalEOTSManager(homeDir, keyringBackend string, dbbackend kvdb.Backend, logger *zap.Logger) (*LocalEOTSManager, error) {
@@ -56,13 +77,22 @@ func NewLocalEOTSManager(homeDir, keyringBackend string, dbbackend kvdb.Backend,
 
 	eotsMetrics := metrics.NewEotsMetrics()
 
-	return &LocalEOTSManager{
-		kr:      kr,
-		es:      es,
-		logger:  logger,
-		input:   inputReader,
-		metrics: eotsMetrics,
-	}, nil
+	lm := &LocalEOTSManager{
+		kr:             kr,
+		es:             es,
+		logger:         logger,
+		input:          inputReader,
+		metrics:        eotsMetrics,
+		homeDir:        homeDir,
+		keyringBackend: keyringBackend,
+		unlockedKeys:   make(map[string]*unlockedKey),
+	}
+
+	if err := lm.loadFreezeState(); err != nil {
+		return nil, fmt.Errorf("failed to load the freeze state: %w", err)
+	}
+
+	return lm, nil
 }
 
 func initKeyring(homeDir, keyringBackend string, inputReader *strings.Reader) (keyring.Keyring, error) {
@@ -143,6 +173,159 @@ func (lm *LocalEOTSManager) CreateKeyWithMnemonic(name, passphrase, hdPath, mnem
 	return eotsPk, nil
 }
 
+// ListKeys returns the information of all the EOTS keys in the keyring
+func (lm *LocalEOTSManager) ListKeys(passphrase string) ([]*eotstypes.KeyInfo, error) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	lm.input.Reset(passphrase)
+	records, err := lm.kr.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keys: %w", err)
+	}
+
+	keys := make([]*eotstypes.KeyInfo, 0, len(records))
+	for _, record := range records {
+		eotsPk, err := loadBIP340PubKeyFromKeyringRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the public key of %s: %w", record.Name, err)
+		}
+		keys = append(keys, &eotstypes.KeyInfo{
+			Name:   record.Name,
+			EOTSPk: eotsPk,
+		})
+	}
+
+	return keys, nil
+}
+
+// KeyInfo returns the information of the EOTS key with the given name
+func (lm *LocalEOTSManager) KeyInfo(name, passphrase string) (*eotstypes.KeyInfo, error) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	lm.input.Reset(passphrase)
+	record, err := lm.kr.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load keyring record for key %s: %w", name, err)
+	}
+
+	eotsPk, err := loadBIP340PubKeyFromKeyringRecord(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eotstypes.KeyInfo{
+		Name:   record.Name,
+		EOTSPk: eotsPk,
+	}, nil
+}
+
+// KeyInfoByPk returns the information of the EOTS key with the given public key
+func (lm *LocalEOTSManager) KeyInfoByPk(fpPk []byte, passphrase string) (*eotstypes.KeyInfo, error) {
+	name, err := lm.es.GetEOTSKeyName(fpPk)
+	if err != nil {
+		return nil, err
+	}
+
+	return lm.KeyInfo(name, passphrase)
+}
+
+// DeleteKey removes the EOTS key with the given name from both
+// the keyring and the mapping of the public key in the db
+func (lm *LocalEOTSManager) DeleteKey(name, passphrase string) (*eotstypes.KeyInfo, error) {
+	keyInfo, err := lm.KeyInfo(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	lm.input.Reset(passphrase)
+	if err := lm.kr.Delete(name); err != nil {
+		return nil, fmt.Errorf("failed to delete key %s from the keyring: %w", name, err)
+	}
+
+	if err := lm.es.DeleteEOTSKeyName(keyInfo.EOTSPk.MustMarshal()); err != nil &&
+		!errors.Is(err, store.ErrEOTSKeyNameNotFound) {
+		return nil, fmt.Errorf("failed to delete key name of %s: %w", keyInfo.EOTSPk.MarshalHex(), err)
+	}
+
+	// the deleted key must not keep signing while it is unlocked
+	if err := lm.Lock(keyInfo.EOTSPk.MustMarshal()); err != nil {
+		return nil, err
+	}
+
+	lm.logger.Info(
+		"successfully deleted an EOTS key",
+		zap.String("key name", name),
+		zap.String("pk", keyInfo.EOTSPk.MarshalHex()),
+	)
+
+	return keyInfo, nil
+}
+
+// ExportKeyArmor returns the EOTS private key with the given name in ASCII armored
+// format, encrypted by the given armor passphrase
+func (lm *LocalEOTSManager) ExportKeyArmor(name, passphrase, armorPassphrase string) (string, error) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	lm.input.Reset(passphrase)
+	armor, err := lm.kr.ExportPrivKeyArmor(name, armorPassphrase)
+	if err != nil {
+		return "", fmt.Errorf("failed to export key %s: %w", name, err)
+	}
+
+	return armor, nil
+}
+
+// ImportKeyArmor imports the ASCII armored EOTS private key encrypted by the given
+// armor passphrase into the keyring under the given name
+func (lm *LocalEOTSManager) ImportKeyArmor(name, passphrase, armor, armorPassphrase string) (*bbntypes.BIP340PubKey, error) {
+	privKey, _, err := sdkcrypto.UnarmorDecryptPrivKey(armor, armorPassphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the armored private key: %w", err)
+	}
+
+	v, ok := privKey.(*secp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %s", privKey.Type())
+	}
+	_, btcPk := btcec.PrivKeyFromBytes(v.Key)
+	eotsPk := bbntypes.NewBIP340PubKeyFromBTCPK(btcPk)
+
+	if lm.keyExists(name) {
+		return nil, eotstypes.ErrFinalityProviderAlreadyExisted
+	}
+	if _, err := lm.es.GetEOTSKeyName(eotsPk.MustMarshal()); err == nil {
+		return nil, fmt.Errorf("%w: %s", store.ErrDuplicateEOTSKeyName, eotsPk.MarshalHex())
+	}
+
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	// we need to repeat the passphrase to mock the re-entry
+	// as the keyring might ask for the passphrase twice
+	lm.input.Reset(passphrase + "\n" + passphrase)
+	if err := lm.kr.ImportPrivKey(name, armor, armorPassphrase); err != nil {
+		return nil, fmt.Errorf("failed to import key %s: %w", name, err)
+	}
+
+	if err := lm.es.AddEOTSKeyName(btcPk, name); err != nil {
+		return nil, err
+	}
+
+	lm.logger.Info(
+		"successfully imported an EOTS key",
+		zap.String("key name", name),
+		zap.String("pk", eotsPk.MarshalHex()),
+	)
+
+	return eotsPk, nil
+}
+
 func loadBIP340PubKeyFromKeyringRecord(record *keyring.Record) (*bbntypes.BIP340PubKey, error) {
 	pubKey, err := record.GetPubKey()
 	if err != nil {
@@ -163,48 +346,209 @@ func loadBIP340PubKeyFromKeyringRecord(record *keyring.Record) (*bbntypes.BIP340
 	}
 }
 
-// TODO the current implementation is a PoC, which does not contain any anti-slasher mechanism
-//
-//	a simple anti-slasher mechanism could be that the manager remembers the tuple (fpPk, chainID, height) or
-//	the hash of each generated randomness and return error if the same randomness is requested twice
+// CreateRandomnessPairList loads the EOTS private key once and derives the
+// public randomness of all the requested heights from it
 func (lm *LocalEOTSManager) CreateRandomnessPairList(fpPk []byte, chainID []byte, startHeight uint64, num uint32, passphrase string) ([]*btcec.FieldVal, error) {
-	prList := make([]*btcec.FieldVal, 0, num)
-
-	for i := uint32(0); i < num; i++ {
-		height := startHeight + uint64(i)
-		_, pubRand, err := lm.getRandomnessPair(fpPk, chainID, height, passphrase)
-		if err != nil {
-			return nil, err
-		}
+	lm.freezeMu.RLock()
+	defer lm.freezeMu.RUnlock()
+	if err := lm.checkFrozen(); err != nil {
+		return nil, err
+	}
 
-		prList = append(prList, pubRand)
+	privKey, err := lm.getSigningKey(fpPk, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
 	}
+
+	prList := randgenerator.GeneratePublicRandomnessList(privKey.Serialize(), chainID, startHeight, num)
+
 	lm.metrics.IncrementEotsFpTotalGeneratedRandomnessCounter(hex.EncodeToString(fpPk))
 	lm.metrics.SetEotsFpLastGeneratedRandomnessHeight(hex.EncodeToString(fpPk), float64(startHeight))
 
 	return prList, nil
 }
 
+// SignEOTS signs the given message at the given height of the given chain
+// The manager persists a record of every EOTS signature it produces, as signing two different
+// messages with the same randomness leaks the EOTS private key. It returns the previous signature
+// if the same message was signed at the height before, and ErrDoubleSign if a different one was.
+// It also refuses to sign new messages at or below the high-water mark of imported signing history
 func (lm *LocalEOTSManager) SignEOTS(fpPk []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
-	privRand, _, err := lm.getRandomnessPair(fpPk, chainID, height, passphrase)
+	sigs, err := lm.SignEOTSBatch(fpPk, chainID, []*eotstypes.SignRequest{{Height: height, Msg: msg}}, passphrase)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get private randomness: %w", err)
+		return nil, err
 	}
 
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	return sigs[0], nil
+}
+
+// SignEOTSBatch signs the given messages at the given heights of the given chain with the
+// same safety checks as SignEOTS. The key is unlocked at most once, and the signing records
+// of the batch are checked and persisted atomically, so either all or none of them are signed
+func (lm *LocalEOTSManager) SignEOTSBatch(fpPk []byte, chainID []byte, reqs []*eotstypes.SignRequest, passphrase string) ([]*btcec.ModNScalar, error) {
+	lm.freezeMu.RLock()
+	defer lm.freezeMu.RUnlock()
+	if err := lm.checkFrozen(); err != nil {
+		return nil, err
+	}
+
+	sigs := make([]*btcec.ModNScalar, len(reqs))
+	msgHashes := make([][]byte, len(reqs))
+	heights := make([]uint64, len(reqs))
+	seen := make(map[uint64]struct{}, len(reqs))
+	for i, req := range reqs {
+		if _, ok := seen[req.Height]; ok {
+			return nil, fmt.Errorf("duplicate height %d in the signing batch", req.Height)
+		}
+		seen[req.Height] = struct{}{}
+		heights[i] = req.Height
+	}
+
+	if err := lm.checkEOTSPolicy(fpPk, chainID, heights); err != nil {
+		return nil, err
+	}
+
+	toSign := make([]int, 0, len(reqs))
+	for i, req := range reqs {
+		msgHash := sha256.Sum256(req.Msg)
+		msgHashes[i] = msgHash[:]
+
+		record, err := lm.es.GetSignRecord(fpPk, chainID, req.Height)
+		if errors.Is(err, store.ErrSignRecordNotFound) {
+			toSign = append(toSign, i)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signing record: %w", err)
+		}
+		if err := lm.checkSignRecord(record, fpPk, chainID, msgHashes[i], req.Height); err != nil {
+			return nil, err
+		}
+		if len(record.Signature) == 0 {
+			// the record is imported without signature, so it is safe to sign the same message again
+			toSign = append(toSign, i)
+			continue
+		}
+		var sig btcec.ModNScalar
+		sig.SetByteSlice(record.Signature)
+		sigs[i] = &sig
+	}
+
+	if len(toSign) == 0 {
+		return sigs, nil
+	}
+
+	privKey, err := lm.getSigningKey(fpPk, passphrase)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
 	}
 
+	signedHeights := make([]uint64, 0, len(toSign))
+	records := make([]*store.SigningRecord, 0, len(toSign))
+	for _, i := range toSign {
+		privRand, _ := randgenerator.GenerateRandomness(privKey.Serialize(), chainID, reqs[i].Height)
+		sig, err := eots.Sign(privKey, privRand, reqs[i].Msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign EOTS at height %d: %w", reqs[i].Height, err)
+		}
+		sigs[i] = sig
+
+		sigBytes := sig.Bytes()
+		signedHeights = append(signedHeights, reqs[i].Height)
+		records = append(records, &store.SigningRecord{
+			MsgHash:   msgHashes[i],
+			Signature: sigBytes[:],
+		})
+	}
+
+	// the signatures must be persisted before they are returned; the checks are
+	// repeated atomically as concurrent requests may have signed in the meantime
+	if err := lm.es.SaveSignRecords(fpPk, chainID, signedHeights, records); err != nil {
+		if errors.Is(err, store.ErrBelowHighWaterMark) {
+			return nil, fmt.Errorf("%w: pk %s, chain %s: %s",
+				eotstypes.ErrBelowHighWaterMark, hex.EncodeToString(fpPk), string(chainID), err.Error())
+		}
+		if errors.Is(err, store.ErrConflictingSignRecord) {
+			lm.logger.Error(
+				"refused to sign a conflicting message at an already signed height",
+				zap.String("pk", hex.EncodeToString(fpPk)),
+				zap.String("chain_id", string(chainID)),
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("%w: pk %s, chain %s: %s",
+				eotstypes.ErrDoubleSign, hex.EncodeToString(fpPk), string(chainID), err.Error())
+		}
+		return nil, fmt.Errorf("failed to save signing records: %w", err)
+	}
+
 	// Update metrics
-	lm.metrics.IncrementEotsFpTotalEotsSignCounter(hex.EncodeToString(fpPk))
-	lm.metrics.SetEotsFpLastEotsSignHeight(hex.EncodeToString(fpPk), float64(height))
+	var lastHeight uint64
+	for _, i := range toSign {
+		lm.metrics.IncrementEotsFpTotalEotsSignCounter(hex.EncodeToString(fpPk))
+		if reqs[i].Height > lastHeight {
+			lastHeight = reqs[i].Height
+		}
+	}
+	lm.metrics.SetEotsFpLastEotsSignHeight(hex.EncodeToString(fpPk), float64(lastHeight))
+
+	return sigs, nil
+}
+
+// checkSignRecord returns ErrDoubleSign if the given signing record was not produced
+// over the message of the given hash
+func (lm *LocalEOTSManager) checkSignRecord(
+	record *store.SigningRecord,
+	fpPk, chainID, msgHash []byte,
+	height uint64,
+) error {
+	if bytes.Equal(record.MsgHash, msgHash) {
+		return nil
+	}
 
-	return eots.Sign(privKey, privRand, msg)
+	lm.logger.Error(
+		"refused to sign a conflicting message at an already signed height",
+		zap.String("pk", hex.EncodeToString(fpPk)),
+		zap.String("chain_id", string(chainID)),
+		zap.Uint64("height", height),
+	)
+
+	return fmt.Errorf("%w: pk %s, chain %s, height %d",
+		eotstypes.ErrDoubleSign, hex.EncodeToString(fpPk), string(chainID), height)
 }
 
 func (lm *LocalEOTSManager) SignSchnorrSig(fpPk []byte, msg []byte, passphrase string) (*schnorr.Signature, error) {
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	lm.freezeMu.RLock()
+	defer lm.freezeMu.RUnlock()
+	if err := lm.checkFrozen(); err != nil {
+		return nil, err
+	}
+
+	if err := lm.checkSchnorrPolicy(fpPk); err != nil {
+		return nil, err
+	}
+
+	return lm.signSchnorrSig(fpPk, msg, passphrase)
+}
+
+// SignSchnorrSigForPayload signs a Schnorr signature over the hash of a recognised payload,
+// which is allowed even if the signing policy restricts Schnorr signatures
+func (lm *LocalEOTSManager) SignSchnorrSigForPayload(fpPk []byte, payload *eotstypes.SchnorrPayload, passphrase string) (*schnorr.Signature, error) {
+	lm.freezeMu.RLock()
+	defer lm.freezeMu.RUnlock()
+	if err := lm.checkFrozen(); err != nil {
+		return nil, err
+	}
+
+	msg, err := payload.HashToSign()
+	if err != nil {
+		return nil, fmt.Errorf("invalid Schnorr payload: %w", err)
+	}
+
+	return lm.signSchnorrSig(fpPk, msg, passphrase)
+}
+
+func (lm *LocalEOTSManager) signSchnorrSig(fpPk []byte, msg []byte, passphrase string) (*schnorr.Signature, error) {
+	privKey, err := lm.getSigningKey(fpPk, passphrase)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
 	}
@@ -244,21 +588,19 @@ func (lm *LocalEOTSManager) SignSchnorrSigFromKeyname(keyName, passphrase string
 }
 
 func (lm *LocalEOTSManager) Close() error {
+	lm.lockAll()
 	return nil
 }
 
-// getRandomnessPair returns a randomness pair generated based on the given finality provider key, chainID and height
-func (lm *LocalEOTSManager) getRandomnessPair(fpPk []byte, chainID []byte, height uint64, passphrase string) (*eots.PrivateRand, *eots.PublicRand, error) {
-	record, err := lm.KeyRecord(fpPk, passphrase)
-	if err != nil {
-		return nil, nil, err
+// KeyRecord returns the name and the private key of the EOTS key, which is
+// refused while the EOTS manager is frozen
+func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstypes.KeyRecord, error) {
+	lm.freezeMu.RLock()
+	defer lm.freezeMu.RUnlock()
+	if err := lm.checkFrozen(); err != nil {
+		return nil, err
 	}
-	privRand, pubRand := randgenerator.GenerateRandomness(record.PrivKey.Serialize(), chainID, height)
-	return privRand, pubRand, nil
-}
 
-// TODO: we ignore passPhrase in local implementation for now
-func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstypes.KeyRecord, error) {
 	name, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
 		return nil, err
@@ -282,8 +624,15 @@ func (lm *LocalEOTSManager) getEOTSPrivKey(fpPk []byte, passphrase string) (*btc
 		return nil, err
 	}
 
+	// a fresh keyring is opened so that the passphrase is checked on every
+	// decryption instead of being cached by the keyring backend
+	kr, err := initKeyring(lm.homeDir, lm.keyringBackend, lm.input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize keyring: %w", err)
+	}
+
 	lm.input.Reset(passphrase)
-	k, err := lm.kr.Key(keyName)
+	k, err := kr.Key(keyName)
 	if err != nil {
 		return nil, err
 	}
@@ -1,16 +1,23 @@
 package eotsmanager_test
 
 import (
+	"encoding/hex"
+	"fmt"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
 	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 	"github.com/babylonlabs-io/finality-provider/testutil"
@@ -92,3 +99,482 @@ func FuzzCreateRandomnessPairList(f *testing.F) {
 		}
 	})
 }
+
+// BenchmarkCreateRandomnessPairList compares unlocking the EOTS key for every
+// height against unlocking it once per request
+func BenchmarkCreateRandomnessPairList(b *testing.B) {
+	homeDir := filepath.Join(b.TempDir(), "eots-home")
+	eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+	dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+	require.NoError(b, err)
+	defer dbBackend.Close()
+	lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+	require.NoError(b, err)
+
+	fpPk, err := lm.CreateKey("bench-key", passphrase, hdPath)
+	require.NoError(b, err)
+	chainID := []byte("chain-test")
+
+	for _, num := range []uint32{100, 1000} {
+		b.Run(fmt.Sprintf("per-height-%d", num), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for h := uint64(0); h < uint64(num); h++ {
+					_, err := lm.CreateRandomnessPairList(fpPk, chainID, h, 1, passphrase)
+					require.NoError(b, err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("per-request-%d", num), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, err := lm.CreateRandomnessPairList(fpPk, chainID, 0, num, passphrase)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// FuzzSignEOTSDoubleSign tests that the EOTS manager refuses to sign
+// conflicting messages at the same height
+func FuzzSignEOTSDoubleSign(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 100)
+		msg := datagen.GenRandomByteArray(r, 32)
+
+		sig, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+
+		// signing the same message again returns the same signature
+		sig2, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		require.True(t, sig.Equals(sig2))
+
+		// signing a different message at the same height is refused
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		// the protection persists across restarts
+		lm, err = eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		// a different height or chain can be signed
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height+1, passphrase)
+		require.NoError(t, err)
+		_, err = lm.SignEOTS(fpPk, datagen.GenRandomByteArray(r, 11), datagen.GenRandomByteArray(r, 32), height, passphrase)
+		require.NoError(t, err)
+	})
+}
+
+// FuzzSignEOTSBatch tests that a batch of messages is signed atomically
+// with the same safety checks as single signing
+func FuzzSignEOTSBatch(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := datagen.RandomInt(r, 100) + 1
+		num := r.Intn(10) + 1
+		reqs := make([]*types.SignRequest, 0, num)
+		for i := 0; i < num; i++ {
+			reqs = append(reqs, &types.SignRequest{
+				Height: startHeight + uint64(i),
+				Msg:    datagen.GenRandomByteArray(r, 32),
+			})
+		}
+
+		// a message signed before is included in the batch
+		sig0, err := lm.SignEOTS(fpPk, chainID, reqs[0].Msg, reqs[0].Height, passphrase)
+		require.NoError(t, err)
+
+		sigs, err := lm.SignEOTSBatch(fpPk, chainID, reqs, passphrase)
+		require.NoError(t, err)
+		require.Len(t, sigs, num)
+		require.True(t, sig0.Equals(sigs[0]))
+		for i, req := range reqs {
+			sig, err := lm.SignEOTS(fpPk, chainID, req.Msg, req.Height, passphrase)
+			require.NoError(t, err)
+			require.True(t, sig.Equals(sigs[i]))
+		}
+
+		// duplicate heights are rejected
+		_, err = lm.SignEOTSBatch(fpPk, chainID, []*types.SignRequest{reqs[0], reqs[0]}, passphrase)
+		require.Error(t, err)
+
+		// a batch conflicting at any height is refused as a whole
+		newHeight := startHeight + uint64(num)
+		conflicting := []*types.SignRequest{
+			{Height: newHeight, Msg: datagen.GenRandomByteArray(r, 32)},
+			{Height: reqs[num-1].Height, Msg: datagen.GenRandomByteArray(r, 32)},
+		}
+		_, err = lm.SignEOTSBatch(fpPk, chainID, conflicting, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+
+		// nothing in the refused batch is signed
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), newHeight, passphrase)
+		require.NoError(t, err)
+	})
+}
+
+// FuzzUnlock tests that an unlocked EOTS key signs without the passphrase
+// until it is locked or expires
+func FuzzUnlock(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, keyring.BackendFile, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 100)
+		signWithoutPassphrase := func() error {
+			height++
+			_, err := lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height, "")
+			return err
+		}
+
+		// the passphrase is required while the key is locked
+		require.Error(t, signWithoutPassphrase())
+		require.Error(t, lm.Unlock(fpPk, "wrong", time.Hour))
+
+		require.NoError(t, lm.Unlock(fpPk, passphrase, time.Hour))
+		require.NoError(t, signWithoutPassphrase())
+		_, err = lm.SignSchnorrSig(fpPk, datagen.GenRandomByteArray(r, 32), "")
+		require.NoError(t, err)
+		_, err = lm.CreateRandomnessPairList(fpPk, chainID, height+1, 10, "")
+		require.NoError(t, err)
+
+		require.NoError(t, lm.Lock(fpPk))
+		require.Error(t, signWithoutPassphrase())
+
+		// the key is wiped when it expires
+		require.NoError(t, lm.Unlock(fpPk, passphrase, 100*time.Millisecond))
+		require.NoError(t, signWithoutPassphrase())
+		require.Eventually(t, func() bool {
+			return signWithoutPassphrase() != nil
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+}
+
+// FuzzSigningHistory tests that the signing history exported from an EOTS manager
+// prevents another EOTS manager holding the same key from signing at the exported heights
+func FuzzSigningHistory(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		mnemonic, err := eotsmanager.NewMnemonic()
+		require.NoError(t, err)
+
+		// the managers hold the same key recovered from the mnemonic
+		newManager := func(homeDir string) (*eotsmanager.LocalEOTSManager, []byte) {
+			eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+			dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				dbBackend.Close()
+			})
+			lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+			require.NoError(t, err)
+			eotsPk, err := lm.CreateKeyWithMnemonic(fpName, passphrase, hdPath, mnemonic)
+			require.NoError(t, err)
+			return lm, eotsPk.MustMarshal()
+		}
+		oldLm, fpPk := newManager(filepath.Join(t.TempDir(), "old-eots-home"))
+		newLm, _ := newManager(filepath.Join(t.TempDir(), "new-eots-home"))
+		newHwmLm, _ := newManager(filepath.Join(t.TempDir(), "new-hwm-eots-home"))
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := datagen.RandomInt(r, 100) + 2
+		num := uint64(r.Intn(10) + 1)
+		msgs := make([][]byte, num)
+		sigs := make([]*btcec.ModNScalar, num)
+		for i := uint64(0); i < num; i++ {
+			msgs[i] = datagen.GenRandomByteArray(r, 32)
+			sigs[i], err = oldLm.SignEOTS(fpPk, chainID, msgs[i], startHeight+i, passphrase)
+			require.NoError(t, err)
+		}
+		hwm := startHeight + num - 1
+
+		history, err := oldLm.ExportSigningHistory(fpPk, false)
+		require.NoError(t, err)
+		require.Len(t, history.Keys, 1)
+		require.Len(t, history.Keys[0].Chains, 1)
+		require.Equal(t, hwm, history.Keys[0].Chains[0].HighWaterMark)
+		require.Len(t, history.Keys[0].Chains[0].Records, int(num))
+
+		// a history with different records at the same height is refused, even if the
+		// chain is listed twice for the key
+		dupRecord := *history.Keys[0].Chains[0].Records[r.Intn(int(num))]
+		dupRecord.MsgHashHex = hex.EncodeToString(datagen.GenRandomByteArray(r, 32))
+		dupChain := *history.Keys[0].Chains[0]
+		dupChain.Records = []*types.SignedHeight{&dupRecord}
+		dupHistory := &types.SigningHistory{
+			Version: history.Version,
+			Keys: []*types.KeySigningHistory{{
+				EOTSPkHex: history.Keys[0].EOTSPkHex,
+				Chains:    []*types.ChainSigningHistory{history.Keys[0].Chains[0], &dupChain},
+			}},
+		}
+		_, err = newLm.ImportSigningHistory(dupHistory)
+		require.ErrorContains(t, err, "duplicate records")
+
+		results, err := newLm.ImportSigningHistory(history)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, hwm, results[0].HighWaterMark)
+		require.Empty(t, results[0].Conflicts)
+
+		// the same message returns the same signature
+		sig, err := newLm.SignEOTS(fpPk, chainID, msgs[0], startHeight, passphrase)
+		require.NoError(t, err)
+		require.True(t, sigs[0].Equals(sig))
+		// a different message at a signed height is refused
+		_, err = newLm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), startHeight, passphrase)
+		require.ErrorIs(t, err, types.ErrDoubleSign)
+		// a height below the high-water mark is refused
+		_, err = newLm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), startHeight-1, passphrase)
+		require.ErrorIs(t, err, types.ErrBelowHighWaterMark)
+		// a height above the high-water mark can be signed
+		_, err = newLm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), hwm+1, passphrase)
+		require.NoError(t, err)
+
+		// only carrying the high-water mark refuses any height at or below it
+		hwmHistory, err := oldLm.ExportSigningHistory(fpPk, true)
+		require.NoError(t, err)
+		require.Empty(t, hwmHistory.Keys[0].Chains[0].Records)
+		_, err = newHwmLm.ImportSigningHistory(hwmHistory)
+		require.NoError(t, err)
+		_, err = newHwmLm.SignEOTS(fpPk, chainID, msgs[0], startHeight, passphrase)
+		require.ErrorIs(t, err, types.ErrBelowHighWaterMark)
+		_, err = newHwmLm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), hwm+1, passphrase)
+		require.NoError(t, err)
+	})
+}
+
+// FuzzSigningPolicy tests that the signing policy refuses to sign on disallowed
+// chains, below the height floor, too far above the last signed height, and
+// Schnorr signatures over unrecognised payloads
+func FuzzSigningPolicy(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := testutil.GenRandomHexStr(r, 8)
+		floor := datagen.RandomInt(r, 1000) + 1
+		maxJump := datagen.RandomInt(r, 100) + 1
+		policy := eotscfg.DefaultPolicyConfig()
+		policy.AllowedChainIDs[hex.EncodeToString(fpPk)] = chainID
+		policy.HeightFloor[eotscfg.PolicyAllKeys] = floor
+		policy.MaxHeightJump[eotscfg.PolicyAllKeys] = maxJump
+		policy.RestrictSchnorr[eotscfg.PolicyAllKeys] = true
+		err = lm.SetSigningPolicy(policy)
+		require.NoError(t, err)
+
+		msg := datagen.GenRandomByteArray(r, 32)
+		_, err = lm.SignEOTS(fpPk, []byte(chainID+"-other"), msg, floor, passphrase)
+		require.ErrorIs(t, err, types.ErrChainNotAllowed)
+		_, err = lm.SignEOTS(fpPk, []byte(chainID), msg, floor-1, passphrase)
+		require.ErrorIs(t, err, types.ErrHeightBelowFloor)
+
+		// the first signature on the chain is not constrained by the height jump
+		lastHeight := floor + maxJump + datagen.RandomInt(r, 1000)
+		_, err = lm.SignEOTS(fpPk, []byte(chainID), msg, lastHeight, passphrase)
+		require.NoError(t, err)
+
+		reqs := []*types.SignRequest{
+			{Height: lastHeight + maxJump, Msg: datagen.GenRandomByteArray(r, 32)},
+			{Height: lastHeight + maxJump + 1, Msg: datagen.GenRandomByteArray(r, 32)},
+		}
+		_, err = lm.SignEOTSBatch(fpPk, []byte(chainID), reqs, passphrase)
+		require.ErrorIs(t, err, types.ErrHeightJumpTooLarge)
+		_, err = lm.SignEOTSBatch(fpPk, []byte(chainID), reqs[:1], passphrase)
+		require.NoError(t, err)
+
+		// only recognised payloads are signed with Schnorr signatures
+		_, err = lm.SignSchnorrSig(fpPk, msg, passphrase)
+		require.ErrorIs(t, err, types.ErrSchnorrPayloadNotAllowed)
+		payload := &types.SchnorrPayload{
+			PubRandCommit: &types.PubRandCommit{
+				StartHeight: lastHeight,
+				NumPubRand:  datagen.RandomInt(r, 100) + 1,
+				Commitment:  datagen.GenRandomByteArray(r, 32),
+			},
+		}
+		sig, err := lm.SignSchnorrSigForPayload(fpPk, payload, passphrase)
+		require.NoError(t, err)
+		hash, err := payload.HashToSign()
+		require.NoError(t, err)
+		pk, err := schnorr.ParsePubKey(fpPk)
+		require.NoError(t, err)
+		require.True(t, sig.Verify(hash, pk))
+	})
+}
+
+// FuzzFreeze tests that a frozen EOTS manager refuses to sign until it is
+// unfrozen, including after it is restarted
+func FuzzFreeze(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, keyring.BackendFile, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+		require.Nil(t, lm.FreezeState())
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 100) + 1
+		msg := datagen.GenRandomByteArray(r, 32)
+
+		reason := testutil.GenRandomHexStr(r, 8)
+		state, err := lm.Freeze(reason)
+		require.NoError(t, err)
+		require.Equal(t, reason, state.Reason)
+
+		// freezing again keeps the original state
+		again, err := lm.Freeze(testutil.GenRandomHexStr(r, 8))
+		require.NoError(t, err)
+		require.Equal(t, state, again)
+
+		_, err = lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.ErrorIs(t, err, types.ErrFrozen)
+		_, err = lm.SignSchnorrSig(fpPk, msg, passphrase)
+		require.ErrorIs(t, err, types.ErrFrozen)
+		_, err = lm.CreateRandomnessPairList(fpPk, chainID, height, 1, passphrase)
+		require.ErrorIs(t, err, types.ErrFrozen)
+		_, err = lm.KeyRecord(fpPk, passphrase)
+		require.ErrorIs(t, err, types.ErrFrozen)
+		// no key is unlocked to sign without the passphrase after unfreezing
+		err = lm.Unlock(fpPk, passphrase, time.Minute)
+		require.ErrorIs(t, err, types.ErrFrozen)
+
+		// the freeze survives restarts
+		lm, err = eotsmanager.NewLocalEOTSManager(homeDir, keyring.BackendFile, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+		require.Equal(t, state, lm.FreezeState())
+		_, err = lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.ErrorIs(t, err, types.ErrFrozen)
+
+		require.NoError(t, lm.Unfreeze())
+		require.Nil(t, lm.FreezeState())
+		_, err = lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		require.NoError(t, lm.Unlock(fpPk, passphrase, time.Minute))
+	})
+}
+
+// TestInfoCompatibility tests that the info of the EOTS manager is compatible with
+// the client of the same build and that the incompatible ones are rejected
+func TestInfoCompatibility(t *testing.T) {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+	dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer dbBackend.Close()
+
+	lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+	require.NoError(t, err)
+
+	info := lm.Info()
+	require.NoError(t, client.CheckCompatibility(info))
+
+	// the EOTS manager predates the handshake
+	require.Error(t, client.CheckCompatibility(&types.ServerInfo{}))
+
+	tooOld := *info
+	tooOld.Version = "0.1.0"
+	require.Error(t, client.CheckCompatibility(&tooOld))
+
+	otherMajor := *info
+	otherMajor.Version = "1.0.0"
+	require.Error(t, client.CheckCompatibility(&otherMajor))
+
+	otherScheme := *info
+	otherScheme.RandScheme = "unknown"
+	require.Error(t, client.CheckCompatibility(&otherScheme))
+
+	noBatch := *info
+	noBatch.Capabilities = []string{types.CapabilityRandomnessStream}
+	require.Error(t, client.CheckCompatibility(&noBatch))
+}
@@ -0,0 +1,162 @@
+package eotsmanager
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+// the rules of the signing policy, which label the policy violation metric
+const (
+	policyRuleAllowedChainIDs = "allowed_chain_ids"
+	policyRuleMaxHeightJump   = "max_height_jump"
+	policyRuleHeightFloor     = "height_floor"
+	policyRuleRestrictSchnorr = "restrict_schnorr"
+)
+
+// signingPolicy is the parsed signing policy whose rules are indexed by the lowercase hex of
+// EOTS public keys, or config.PolicyAllKeys
+type signingPolicy struct {
+	allowedChainIDs map[string]map[string]struct{}
+	maxHeightJump   map[string]uint64
+	heightFloor     map[string]uint64
+	restrictSchnorr map[string]bool
+}
+
+func newSigningPolicy(cfg *config.PolicyConfig) (*signingPolicy, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid signing policy: %w", err)
+	}
+
+	p := &signingPolicy{
+		allowedChainIDs: make(map[string]map[string]struct{}, len(cfg.AllowedChainIDs)),
+		maxHeightJump:   make(map[string]uint64, len(cfg.MaxHeightJump)),
+		heightFloor:     make(map[string]uint64, len(cfg.HeightFloor)),
+		restrictSchnorr: make(map[string]bool, len(cfg.RestrictSchnorr)),
+	}
+	for k, v := range cfg.AllowedChainIDs {
+		chainIDs := make(map[string]struct{})
+		for _, chainID := range strings.Split(v, ",") {
+			chainIDs[strings.TrimSpace(chainID)] = struct{}{}
+		}
+		p.allowedChainIDs[strings.ToLower(k)] = chainIDs
+	}
+	for k, v := range cfg.MaxHeightJump {
+		p.maxHeightJump[strings.ToLower(k)] = v
+	}
+	for k, v := range cfg.HeightFloor {
+		p.heightFloor[strings.ToLower(k)] = v
+	}
+	for k, v := range cfg.RestrictSchnorr {
+		p.restrictSchnorr[strings.ToLower(k)] = v
+	}
+
+	return p, nil
+}
+
+// policyRule returns the rule of the given key, falling back to the rule of all keys
+func policyRule[T any](rules map[string]T, pkHex string) (T, bool) {
+	if v, ok := rules[pkHex]; ok {
+		return v, true
+	}
+	v, ok := rules[config.PolicyAllKeys]
+	return v, ok
+}
+
+// SetSigningPolicy sets the signing policy enforced on the EOTS keys. No policy is enforced
+// if it is not set
+func (lm *LocalEOTSManager) SetSigningPolicy(cfg *config.PolicyConfig) error {
+	p, err := newSigningPolicy(cfg)
+	if err != nil {
+		return err
+	}
+
+	lm.policyMu.Lock()
+	lm.policy = p
+	lm.policyMu.Unlock()
+
+	return nil
+}
+
+func (lm *LocalEOTSManager) getSigningPolicy() *signingPolicy {
+	lm.policyMu.RLock()
+	defer lm.policyMu.RUnlock()
+	return lm.policy
+}
+
+// checkEOTSPolicy checks the signing policy allows the key to sign EOTS at the given heights
+// of the given chain
+func (lm *LocalEOTSManager) checkEOTSPolicy(fpPk []byte, chainID []byte, heights []uint64) error {
+	p := lm.getSigningPolicy()
+	if p == nil {
+		return nil
+	}
+	pkHex := hex.EncodeToString(fpPk)
+
+	if allowed, ok := policyRule(p.allowedChainIDs, pkHex); ok {
+		if _, ok := allowed[string(chainID)]; !ok {
+			return lm.policyViolation(pkHex, policyRuleAllowedChainIDs,
+				fmt.Errorf("%w: chain ID %s", types.ErrChainNotAllowed, chainID))
+		}
+	}
+
+	if floor, ok := policyRule(p.heightFloor, pkHex); ok {
+		for _, h := range heights {
+			if h < floor {
+				return lm.policyViolation(pkHex, policyRuleHeightFloor,
+					fmt.Errorf("%w: height %d, floor %d", types.ErrHeightBelowFloor, h, floor))
+			}
+		}
+	}
+
+	if maxJump, ok := policyRule(p.maxHeightJump, pkHex); ok {
+		lastHeight, err := lm.es.GetLastSignedHeight(fpPk, chainID)
+		if err != nil {
+			return fmt.Errorf("failed to get the last signed height: %w", err)
+		}
+		// the jump is not constrained until the key signs on the chain for the first time
+		if lastHeight != 0 {
+			for _, h := range heights {
+				if h > lastHeight && h-lastHeight > maxJump {
+					return lm.policyViolation(pkHex, policyRuleMaxHeightJump,
+						fmt.Errorf("%w: height %d, last signed height %d, max jump %d",
+							types.ErrHeightJumpTooLarge, h, lastHeight, maxJump))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSchnorrPolicy checks the signing policy allows the key to sign Schnorr signatures over
+// arbitrary messages
+func (lm *LocalEOTSManager) checkSchnorrPolicy(fpPk []byte) error {
+	p := lm.getSigningPolicy()
+	if p == nil {
+		return nil
+	}
+	pkHex := hex.EncodeToString(fpPk)
+
+	if restricted, ok := policyRule(p.restrictSchnorr, pkHex); ok && restricted {
+		return lm.policyViolation(pkHex, policyRuleRestrictSchnorr, types.ErrSchnorrPayloadNotAllowed)
+	}
+
+	return nil
+}
+
+func (lm *LocalEOTSManager) policyViolation(pkHex, rule string, err error) error {
+	lm.metrics.IncrementEotsFpPolicyViolationCounter(pkHex, rule)
+	lm.logger.Warn(
+		"the signing policy refused to sign",
+		zap.String("pk", pkHex),
+		zap.String("rule", rule),
+		zap.Error(err),
+	)
+	return err
+}
@@ -19,29 +19,62 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	EOTSManager_Ping_FullMethodName                     = "/proto.EOTSManager/Ping"
-	EOTSManager_CreateKey_FullMethodName                = "/proto.EOTSManager/CreateKey"
-	EOTSManager_CreateRandomnessPairList_FullMethodName = "/proto.EOTSManager/CreateRandomnessPairList"
-	EOTSManager_KeyRecord_FullMethodName                = "/proto.EOTSManager/KeyRecord"
-	EOTSManager_SignEOTS_FullMethodName                 = "/proto.EOTSManager/SignEOTS"
-	EOTSManager_SignSchnorrSig_FullMethodName           = "/proto.EOTSManager/SignSchnorrSig"
+	EOTSManager_Ping_FullMethodName                           = "/proto.EOTSManager/Ping"
+	EOTSManager_CreateKey_FullMethodName                      = "/proto.EOTSManager/CreateKey"
+	EOTSManager_CreateRandomnessPairList_FullMethodName       = "/proto.EOTSManager/CreateRandomnessPairList"
+	EOTSManager_CreateRandomnessPairListStream_FullMethodName = "/proto.EOTSManager/CreateRandomnessPairListStream"
+	EOTSManager_KeyRecord_FullMethodName                      = "/proto.EOTSManager/KeyRecord"
+	EOTSManager_SignEOTS_FullMethodName                       = "/proto.EOTSManager/SignEOTS"
+	EOTSManager_SignEOTSBatch_FullMethodName                  = "/proto.EOTSManager/SignEOTSBatch"
+	EOTSManager_SignSchnorrSig_FullMethodName                 = "/proto.EOTSManager/SignSchnorrSig"
+	EOTSManager_SignSchnorrSigForPayload_FullMethodName       = "/proto.EOTSManager/SignSchnorrSigForPayload"
+	EOTSManager_Unlock_FullMethodName                         = "/proto.EOTSManager/Unlock"
+	EOTSManager_Lock_FullMethodName                           = "/proto.EOTSManager/Lock"
+	EOTSManager_ListAuditRecords_FullMethodName               = "/proto.EOTSManager/ListAuditRecords"
+	EOTSManager_Freeze_FullMethodName                         = "/proto.EOTSManager/Freeze"
+	EOTSManager_Unfreeze_FullMethodName                       = "/proto.EOTSManager/Unfreeze"
+	EOTSManager_GetFreezeState_FullMethodName                 = "/proto.EOTSManager/GetFreezeState"
 )
 
 // EOTSManagerClient is the client API for EOTSManager service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EOTSManagerClient interface {
+	// Ping returns the version, randomness derivation scheme and capabilities
+	// of the EOTS manager
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	// CreateKey generates and saves an EOTS key
 	CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error)
 	// CreateRandomnessPairList returns a list of Schnorr randomness pairs
 	CreateRandomnessPairList(ctx context.Context, in *CreateRandomnessPairListRequest, opts ...grpc.CallOption) (*CreateRandomnessPairListResponse, error)
+	// CreateRandomnessPairListStream returns a list of Schnorr randomness pairs
+	// in chunks, so that long lists do not exceed the gRPC message size limit
+	CreateRandomnessPairListStream(ctx context.Context, in *CreateRandomnessPairListRequest, opts ...grpc.CallOption) (EOTSManager_CreateRandomnessPairListStreamClient, error)
 	// KeyRecord returns the key record
 	KeyRecord(ctx context.Context, in *KeyRecordRequest, opts ...grpc.CallOption) (*KeyRecordResponse, error)
 	// SignEOTS signs an EOTS with the EOTS private key and the relevant randomness
 	SignEOTS(ctx context.Context, in *SignEOTSRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error)
+	// SignEOTSBatch signs a list of EOTS at distinct heights with the EOTS private key
+	// and the relevant randomness. Either all or none of the messages are signed
+	SignEOTSBatch(ctx context.Context, in *SignEOTSBatchRequest, opts ...grpc.CallOption) (*SignEOTSBatchResponse, error)
 	// SignSchnorrSig signs a Schnorr sig with the EOTS private key
 	SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error)
+	// SignSchnorrSigForPayload signs a Schnorr sig over the hash of a recognised
+	// payload with the EOTS private key
+	SignSchnorrSigForPayload(ctx context.Context, in *SignSchnorrSigForPayloadRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error)
+	// Unlock keeps the decrypted EOTS private key in memory for the given duration,
+	// during which the key signs without the passphrase
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// Lock wipes the unlocked EOTS private key from memory
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	// ListAuditRecords returns a page of the audit log matching the filters
+	ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error)
+	// Freeze makes the EOTS manager refuse to sign until it is unfrozen
+	Freeze(ctx context.Context, in *FreezeRequest, opts ...grpc.CallOption) (*FreezeResponse, error)
+	// Unfreeze lifts the freeze of the EOTS manager
+	Unfreeze(ctx context.Context, in *UnfreezeRequest, opts ...grpc.CallOption) (*UnfreezeResponse, error)
+	// GetFreezeState returns whether the EOTS manager is frozen
+	GetFreezeState(ctx context.Context, in *GetFreezeStateRequest, opts ...grpc.CallOption) (*GetFreezeStateResponse, error)
 }
 
 type eOTSManagerClient struct {
@@ -79,6 +112,38 @@ func (c *eOTSManagerClient) CreateRandomnessPairList(ctx context.Context, in *Cr
 	return out, nil
 }
 
+func (c *eOTSManagerClient) CreateRandomnessPairListStream(ctx context.Context, in *CreateRandomnessPairListRequest, opts ...grpc.CallOption) (EOTSManager_CreateRandomnessPairListStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EOTSManager_ServiceDesc.Streams[0], EOTSManager_CreateRandomnessPairListStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eOTSManagerCreateRandomnessPairListStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EOTSManager_CreateRandomnessPairListStreamClient interface {
+	Recv() (*CreateRandomnessPairListChunk, error)
+	grpc.ClientStream
+}
+
+type eOTSManagerCreateRandomnessPairListStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *eOTSManagerCreateRandomnessPairListStreamClient) Recv() (*CreateRandomnessPairListChunk, error) {
+	m := new(CreateRandomnessPairListChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *eOTSManagerClient) KeyRecord(ctx context.Context, in *KeyRecordRequest, opts ...grpc.CallOption) (*KeyRecordResponse, error) {
 	out := new(KeyRecordResponse)
 	err := c.cc.Invoke(ctx, EOTSManager_KeyRecord_FullMethodName, in, out, opts...)
@@ -97,6 +162,15 @@ func (c *eOTSManagerClient) SignEOTS(ctx context.Context, in *SignEOTSRequest, o
 	return out, nil
 }
 
+func (c *eOTSManagerClient) SignEOTSBatch(ctx context.Context, in *SignEOTSBatchRequest, opts ...grpc.CallOption) (*SignEOTSBatchResponse, error) {
+	out := new(SignEOTSBatchResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_SignEOTSBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eOTSManagerClient) SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error) {
 	out := new(SignSchnorrSigResponse)
 	err := c.cc.Invoke(ctx, EOTSManager_SignSchnorrSig_FullMethodName, in, out, opts...)
@@ -106,21 +180,108 @@ func (c *eOTSManagerClient) SignSchnorrSig(ctx context.Context, in *SignSchnorrS
 	return out, nil
 }
 
+func (c *eOTSManagerClient) SignSchnorrSigForPayload(ctx context.Context, in *SignSchnorrSigForPayloadRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error) {
+	out := new(SignSchnorrSigResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_SignSchnorrSigForPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_Unlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_Lock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error) {
+	out := new(ListAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_ListAuditRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) Freeze(ctx context.Context, in *FreezeRequest, opts ...grpc.CallOption) (*FreezeResponse, error) {
+	out := new(FreezeResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_Freeze_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) Unfreeze(ctx context.Context, in *UnfreezeRequest, opts ...grpc.CallOption) (*UnfreezeResponse, error) {
+	out := new(UnfreezeResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_Unfreeze_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) GetFreezeState(ctx context.Context, in *GetFreezeStateRequest, opts ...grpc.CallOption) (*GetFreezeStateResponse, error) {
+	out := new(GetFreezeStateResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_GetFreezeState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EOTSManagerServer is the server API for EOTSManager service.
 // All implementations must embed UnimplementedEOTSManagerServer
 // for forward compatibility
 type EOTSManagerServer interface {
+	// Ping returns the version, randomness derivation scheme and capabilities
+	// of the EOTS manager
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	// CreateKey generates and saves an EOTS key
 	CreateKey(context.Context, *CreateKeyRequest) (*CreateKeyResponse, error)
 	// CreateRandomnessPairList returns a list of Schnorr randomness pairs
 	CreateRandomnessPairList(context.Context, *CreateRandomnessPairListRequest) (*CreateRandomnessPairListResponse, error)
+	// CreateRandomnessPairListStream returns a list of Schnorr randomness pairs
+	// in chunks, so that long lists do not exceed the gRPC message size limit
+	CreateRandomnessPairListStream(*CreateRandomnessPairListRequest, EOTSManager_CreateRandomnessPairListStreamServer) error
 	// KeyRecord returns the key record
 	KeyRecord(context.Context, *KeyRecordRequest) (*KeyRecordResponse, error)
 	// SignEOTS signs an EOTS with the EOTS private key and the relevant randomness
 	SignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error)
+	// SignEOTSBatch signs a list of EOTS at distinct heights with the EOTS private key
+	// and the relevant randomness. Either all or none of the messages are signed
+	SignEOTSBatch(context.Context, *SignEOTSBatchRequest) (*SignEOTSBatchResponse, error)
 	// SignSchnorrSig signs a Schnorr sig with the EOTS private key
 	SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error)
+	// SignSchnorrSigForPayload signs a Schnorr sig over the hash of a recognised
+	// payload with the EOTS private key
+	SignSchnorrSigForPayload(context.Context, *SignSchnorrSigForPayloadRequest) (*SignSchnorrSigResponse, error)
+	// Unlock keeps the decrypted EOTS private key in memory for the given duration,
+	// during which the key signs without the passphrase
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// Lock wipes the unlocked EOTS private key from memory
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	// ListAuditRecords returns a page of the audit log matching the filters
+	ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+	// Freeze makes the EOTS manager refuse to sign until it is unfrozen
+	Freeze(context.Context, *FreezeRequest) (*FreezeResponse, error)
+	// Unfreeze lifts the freeze of the EOTS manager
+	Unfreeze(context.Context, *UnfreezeRequest) (*UnfreezeResponse, error)
+	// GetFreezeState returns whether the EOTS manager is frozen
+	GetFreezeState(context.Context, *GetFreezeStateRequest) (*GetFreezeStateResponse, error)
 	mustEmbedUnimplementedEOTSManagerServer()
 }
 
@@ -137,15 +298,42 @@ func (UnimplementedEOTSManagerServer) CreateKey(context.Context, *CreateKeyReque
 func (UnimplementedEOTSManagerServer) CreateRandomnessPairList(context.Context, *CreateRandomnessPairListRequest) (*CreateRandomnessPairListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRandomnessPairList not implemented")
 }
+func (UnimplementedEOTSManagerServer) CreateRandomnessPairListStream(*CreateRandomnessPairListRequest, EOTSManager_CreateRandomnessPairListStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateRandomnessPairListStream not implemented")
+}
 func (UnimplementedEOTSManagerServer) KeyRecord(context.Context, *KeyRecordRequest) (*KeyRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KeyRecord not implemented")
 }
 func (UnimplementedEOTSManagerServer) SignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignEOTS not implemented")
 }
+func (UnimplementedEOTSManagerServer) SignEOTSBatch(context.Context, *SignEOTSBatchRequest) (*SignEOTSBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignEOTSBatch not implemented")
+}
 func (UnimplementedEOTSManagerServer) SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignSchnorrSig not implemented")
 }
+func (UnimplementedEOTSManagerServer) SignSchnorrSigForPayload(context.Context, *SignSchnorrSigForPayloadRequest) (*SignSchnorrSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSchnorrSigForPayload not implemented")
+}
+func (UnimplementedEOTSManagerServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedEOTSManagerServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedEOTSManagerServer) ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditRecords not implemented")
+}
+func (UnimplementedEOTSManagerServer) Freeze(context.Context, *FreezeRequest) (*FreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Freeze not implemented")
+}
+func (UnimplementedEOTSManagerServer) Unfreeze(context.Context, *UnfreezeRequest) (*UnfreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfreeze not implemented")
+}
+func (UnimplementedEOTSManagerServer) GetFreezeState(context.Context, *GetFreezeStateRequest) (*GetFreezeStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreezeState not implemented")
+}
 func (UnimplementedEOTSManagerServer) mustEmbedUnimplementedEOTSManagerServer() {}
 
 // UnsafeEOTSManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -213,6 +401,27 @@ func _EOTSManager_CreateRandomnessPairList_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_CreateRandomnessPairListStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CreateRandomnessPairListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EOTSManagerServer).CreateRandomnessPairListStream(m, &eOTSManagerCreateRandomnessPairListStreamServer{stream})
+}
+
+type EOTSManager_CreateRandomnessPairListStreamServer interface {
+	Send(*CreateRandomnessPairListChunk) error
+	grpc.ServerStream
+}
+
+type eOTSManagerCreateRandomnessPairListStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *eOTSManagerCreateRandomnessPairListStreamServer) Send(m *CreateRandomnessPairListChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _EOTSManager_KeyRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyRecordRequest)
 	if err := dec(in); err != nil {
@@ -249,6 +458,24 @@ func _EOTSManager_SignEOTS_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_SignEOTSBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignEOTSBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).SignEOTSBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_SignEOTSBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).SignEOTSBatch(ctx, req.(*SignEOTSBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EOTSManager_SignSchnorrSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignSchnorrSigRequest)
 	if err := dec(in); err != nil {
@@ -267,6 +494,132 @@ func _EOTSManager_SignSchnorrSig_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_SignSchnorrSigForPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSchnorrSigForPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).SignSchnorrSigForPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_SignSchnorrSigForPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).SignSchnorrSigForPayload(ctx, req.(*SignSchnorrSigForPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_Unlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_Lock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_ListAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).ListAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_ListAuditRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).ListAuditRecords(ctx, req.(*ListAuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_Freeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).Freeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_Freeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).Freeze(ctx, req.(*FreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_Unfreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).Unfreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_Unfreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).Unfreeze(ctx, req.(*UnfreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_GetFreezeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreezeStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).GetFreezeState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_GetFreezeState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).GetFreezeState(ctx, req.(*GetFreezeStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EOTSManager_ServiceDesc is the grpc.ServiceDesc for EOTSManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -294,11 +647,49 @@ var EOTSManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignEOTS",
 			Handler:    _EOTSManager_SignEOTS_Handler,
 		},
+		{
+			MethodName: "SignEOTSBatch",
+			Handler:    _EOTSManager_SignEOTSBatch_Handler,
+		},
 		{
 			MethodName: "SignSchnorrSig",
 			Handler:    _EOTSManager_SignSchnorrSig_Handler,
 		},
+		{
+			MethodName: "SignSchnorrSigForPayload",
+			Handler:    _EOTSManager_SignSchnorrSigForPayload_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _EOTSManager_Unlock_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _EOTSManager_Lock_Handler,
+		},
+		{
+			MethodName: "ListAuditRecords",
+			Handler:    _EOTSManager_ListAuditRecords_Handler,
+		},
+		{
+			MethodName: "Freeze",
+			Handler:    _EOTSManager_Freeze_Handler,
+		},
+		{
+			MethodName: "Unfreeze",
+			Handler:    _EOTSManager_Unfreeze_Handler,
+		},
+		{
+			MethodName: "GetFreezeState",
+			Handler:    _EOTSManager_GetFreezeState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CreateRandomnessPairListStream",
+			Handler:       _EOTSManager_CreateRandomnessPairListStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "eotsmanager.proto",
 }
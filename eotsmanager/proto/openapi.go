@@ -0,0 +1,9 @@
+package proto
+
+import _ "embed"
+
+// OpenAPISpec is the OpenAPI spec of the REST gateway of the EOTS manager, which is
+// generated from eotsmanager.proto and the routes in eotsmanager_gateway.yaml
+//
+//go:embed eotsmanager.swagger.json
+var OpenAPISpec []byte
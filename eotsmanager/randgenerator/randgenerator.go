@@ -3,6 +3,8 @@ package randgenerator
 import (
 	"crypto/hmac"
 	"crypto/sha256"
+	"runtime"
+	"sync"
 
 	"github.com/babylonlabs-io/babylon/crypto/eots"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -10,6 +12,16 @@ import (
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
+// SchemeID identifies the derivation of the randomness by GenerateRandomness, i.e., the
+// HMAC-SHA256 keyed by the EOTS private key over the big-endian height followed by the
+// chain ID. It must change whenever the derivation changes, as the randomness committed
+// under one scheme cannot be used to sign under another
+const SchemeID = "hmac-sha256-height-chainid-v1"
+
+// minHeightsPerWorker is the minimum number of heights handled by each worker
+// so that small lists are not split across goroutines
+const minHeightsPerWorker = 256
+
 // GenerateRandomness generates a random scalar with the given key and src
 // the result is deterministic with each given input
 func GenerateRandomness(key []byte, chainID []byte, height uint64) (*eots.PrivateRand, *eots.PublicRand) {
@@ -27,3 +39,45 @@ func GenerateRandomness(key []byte, chainID []byte, height uint64) (*eots.Privat
 
 	return &privRand.Key, &j.X
 }
+
+// GeneratePublicRandomnessList generates the public randomness from startHeight to
+// startHeight+(num-1) with the given key and chainID
+// The generation is split across at most GOMAXPROCS workers
+func GeneratePublicRandomnessList(key []byte, chainID []byte, startHeight uint64, num uint32) []*eots.PublicRand {
+	pubRandList := make([]*eots.PublicRand, num)
+
+	numWorkers := runtime.GOMAXPROCS(0)
+	if maxWorkers := int(num) / minHeightsPerWorker; maxWorkers < numWorkers {
+		numWorkers = maxWorkers
+	}
+	if numWorkers <= 1 {
+		generatePublicRandomnessRange(key, chainID, startHeight, pubRandList)
+		return pubRandList
+	}
+
+	// each worker fills a contiguous range of the list
+	chunkSize := (len(pubRandList) + numWorkers - 1) / numWorkers
+	var wg sync.WaitGroup
+	for start := 0; start < len(pubRandList); start += chunkSize {
+		end := start + chunkSize
+		if end > len(pubRandList) {
+			end = len(pubRandList)
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			generatePublicRandomnessRange(key, chainID, startHeight+uint64(start), pubRandList[start:end])
+		}(start, end)
+	}
+	wg.Wait()
+
+	return pubRandList
+}
+
+func generatePublicRandomnessRange(key []byte, chainID []byte, startHeight uint64, pubRandList []*eots.PublicRand) {
+	for i := range pubRandList {
+		_, pubRand := GenerateRandomness(key, chainID, startHeight+uint64(i))
+		pubRandList[i] = pubRand
+	}
+}
@@ -0,0 +1,55 @@
+package randgenerator_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/randgenerator"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzGeneratePublicRandomnessList tests the parallel generation of the public
+// randomness list matches the generation height by height
+func FuzzGeneratePublicRandomnessList(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		key := datagen.GenRandomByteArray(r, 32)
+		chainID := datagen.GenRandomByteArray(r, 10)
+		startHeight := r.Uint64()
+		num := uint32(r.Intn(3000))
+
+		pubRandList := randgenerator.GeneratePublicRandomnessList(key, chainID, startHeight, num)
+		require.Len(t, pubRandList, int(num))
+		for i, pubRand := range pubRandList {
+			_, expectedPubRand := randgenerator.GenerateRandomness(key, chainID, startHeight+uint64(i))
+			require.True(t, expectedPubRand.Equals(pubRand))
+		}
+	})
+}
+
+func BenchmarkGeneratePublicRandomnessList(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	key := datagen.GenRandomByteArray(r, 32)
+	chainID := []byte("chain-test")
+
+	for _, num := range []uint32{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("sequential-%d", num), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for h := uint64(0); h < uint64(num); h++ {
+					randgenerator.GenerateRandomness(key, chainID, h)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("parallel-%d", num), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				randgenerator.GeneratePublicRandomnessList(key, chainID, 0, num)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/peer"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+const (
+	// auditPruneInterval is the interval of pruning the audit records older than the retention
+	auditPruneInterval = time.Hour
+
+	defaultAuditPageLimit = 100
+	maxAuditPageLimit     = 1000
+)
+
+// audit appends the records of an operation to the audit log with the outcome of
+// the operation and the address of the caller. Failing to audit the operation is
+// logged without failing the operation, which has already taken effect
+func (r *rpcServer) audit(ctx context.Context, records []*types.AuditRecord, opErr error) {
+	outcome, errMsg := auditOutcome(opErr)
+	var peerAddr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerAddr = p.Addr.String()
+	}
+
+	now := time.Now().UTC()
+	for _, record := range records {
+		record.Timestamp = now
+		record.Peer = peerAddr
+		record.Outcome = outcome
+		record.Error = errMsg
+	}
+
+	if err := r.em.AppendAuditRecords(records); err != nil {
+		r.logger.Error("failed to audit the EOTS manager operation", zap.Error(err))
+	}
+}
+
+// auditOutcome returns the outcome and the error message of an operation
+func auditOutcome(err error) (string, string) {
+	if err == nil {
+		return types.AuditOutcomeSuccess, ""
+	}
+
+	for _, typedErrs := range [][]error{types.SigningErrors, types.PolicyErrors} {
+		for _, typedErr := range typedErrs {
+			if errors.Is(err, typedErr) {
+				return types.AuditOutcomeRejected, err.Error()
+			}
+		}
+	}
+
+	return types.AuditOutcomeFailed, err.Error()
+}
+
+// msgHashHex returns the hex of the sha256 hash of a signed message
+func msgHashHex(msg []byte) string {
+	msgHash := sha256.Sum256(msg)
+	return hex.EncodeToString(msgHash[:])
+}
+
+// ListAuditRecords returns a page of the audit log matching the filters
+func (r *rpcServer) ListAuditRecords(ctx context.Context, req *proto.ListAuditRecordsRequest) (
+	*proto.ListAuditRecordsResponse, error) {
+
+	filter := &types.AuditFilter{
+		EOTSPkHex: hex.EncodeToString(req.Uid),
+		ChainID:   string(req.ChainId),
+		Operation: req.Operation,
+		Outcome:   req.Outcome,
+	}
+	if req.StartTime != 0 {
+		filter.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime != 0 {
+		filter.EndTime = time.Unix(req.EndTime, 0)
+	}
+
+	limit := uint64(req.Limit)
+	if limit == 0 {
+		limit = defaultAuditPageLimit
+	}
+	if limit > maxAuditPageLimit {
+		limit = maxAuditPageLimit
+	}
+
+	records, nextID, err := r.em.ListAuditRecords(filter, req.PageKey, limit)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	res := &proto.ListAuditRecordsResponse{
+		Records:     make([]*proto.AuditRecord, 0, len(records)),
+		NextPageKey: nextID,
+	}
+	for _, record := range records {
+		protoRecord, err := toProtoAuditRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		res.Records = append(res.Records, protoRecord)
+	}
+
+	return res, nil
+}
+
+func toProtoAuditRecord(r *types.AuditRecord) (*proto.AuditRecord, error) {
+	pk, err := hex.DecodeString(r.EOTSPkHex)
+	if err != nil {
+		return nil, err
+	}
+	msgHash, err := hex.DecodeString(r.MsgHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AuditRecord{
+		Id:         r.ID,
+		Timestamp:  r.Timestamp.UnixNano(),
+		Operation:  r.Operation,
+		Uid:        pk,
+		KeyName:    r.KeyName,
+		ChainId:    []byte(r.ChainID),
+		Height:     r.Height,
+		NumPubRand: r.NumPubRand,
+		MsgHash:    msgHash,
+		Peer:       r.Peer,
+		Outcome:    r.Outcome,
+		Error:      r.Error,
+	}, nil
+}
+
+// pruneAuditLog periodically prunes the audit records older than the retention
+// until the server quits
+func (s *Server) pruneAuditLog() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(auditPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		numPruned, err := s.rpcServer.em.PruneAuditRecords(s.cfg.AuditRetention)
+		if err != nil {
+			s.logger.Error("failed to prune the audit log", zap.Error(err))
+		} else if numPruned > 0 {
+			s.logger.Info("pruned the audit log", zap.Int("num_records", numPruned))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+	}
+}
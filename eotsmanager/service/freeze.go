@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+// freezeFilePollInterval is the interval of checking whether the freeze file exists
+const freezeFilePollInterval = time.Second
+
+// Freeze makes the EOTS manager refuse to sign until it is unfrozen
+func (r *rpcServer) Freeze(ctx context.Context, req *proto.FreezeRequest) (
+	*proto.FreezeResponse, error) {
+
+	state, err := r.em.Freeze(req.Reason)
+	r.audit(ctx, []*types.AuditRecord{{Operation: types.AuditOperationFreeze}}, err)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	return &proto.FreezeResponse{State: toProtoFreezeState(state)}, nil
+}
+
+// Unfreeze lifts the freeze of the EOTS manager
+func (r *rpcServer) Unfreeze(ctx context.Context, req *proto.UnfreezeRequest) (
+	*proto.UnfreezeResponse, error) {
+
+	err := r.unfreeze()
+	r.audit(ctx, []*types.AuditRecord{{Operation: types.AuditOperationUnfreeze}}, err)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	return &proto.UnfreezeResponse{}, nil
+}
+
+func (r *rpcServer) unfreeze() error {
+	// the EOTS manager would be frozen again right away while the freeze file exists
+	if r.freezeFile != "" {
+		if _, err := os.Stat(r.freezeFile); err == nil {
+			return status.Errorf(codes.FailedPrecondition,
+				"the freeze file %s exists, remove it before unfreezing", r.freezeFile)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to check the freeze file %s: %w", r.freezeFile, err)
+		}
+	}
+
+	return r.em.Unfreeze()
+}
+
+// GetFreezeState returns whether the EOTS manager is frozen
+func (r *rpcServer) GetFreezeState(ctx context.Context, req *proto.GetFreezeStateRequest) (
+	*proto.GetFreezeStateResponse, error) {
+
+	return &proto.GetFreezeStateResponse{State: toProtoFreezeState(r.em.FreezeState())}, nil
+}
+
+func toProtoFreezeState(state *types.FreezeState) *proto.FreezeState {
+	if state == nil {
+		return nil
+	}
+
+	return &proto.FreezeState{
+		Reason:   state.Reason,
+		FrozenAt: state.FrozenAt.Unix(),
+	}
+}
+
+// watchFreezeFile periodically checks whether the freeze file exists, and freezes the
+// EOTS manager once it does, until the server quits. Removing the file does not unfreeze
+// the EOTS manager, which is lifted explicitly
+func (s *Server) watchFreezeFile() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(freezeFilePollInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := os.Stat(s.cfg.FreezeFile)
+		switch {
+		case err == nil:
+			if s.rpcServer.em.FreezeState() == nil {
+				reason := fmt.Sprintf("the freeze file %s exists", s.cfg.FreezeFile)
+				_, err := s.rpcServer.em.Freeze(reason)
+				s.rpcServer.audit(context.Background(), []*types.AuditRecord{{Operation: types.AuditOperationFreeze}}, err)
+				if err != nil {
+					s.logger.Error("failed to freeze the EOTS manager", zap.Error(err))
+				}
+			}
+		case !errors.Is(err, os.ErrNotExist):
+			s.logger.Error("failed to check the freeze file", zap.String("path", s.cfg.FreezeFile), zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+	}
+}
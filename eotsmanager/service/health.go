@@ -0,0 +1,27 @@
+package service
+
+import (
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/health"
+)
+
+// The subsystems of the EOTS manager reported by the health service
+const (
+	healthSubsystemDB      = "db"
+	healthSubsystemKeyring = "keyring"
+	healthSubsystemSigning = "signing"
+)
+
+// newHealthChecker creates the health checker of the EOTS manager, which is not ready
+// if its database or keyring cannot be read, or if it is frozen and refuses to sign
+func newHealthChecker(em *eotsmanager.LocalEOTSManager, db kvdb.Backend, logger *zap.Logger) *health.Checker {
+	checker := health.NewChecker(logger)
+	checker.AddCheck(healthSubsystemDB, health.DBCheck(db))
+	checker.AddCheck(healthSubsystemKeyring, em.CheckKeyring)
+	checker.AddCheck(healthSubsystemSigning, em.CheckSigning)
+
+	return checker
+}
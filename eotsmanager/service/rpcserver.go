@@ -2,28 +2,47 @@ package service
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
+	"time"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 )
 
+// pubRandChunkSize is the number of public randomness sent in each chunk of
+// CreateRandomnessPairListStream, which keeps every message far below the
+// default gRPC message size limit
+const pubRandChunkSize = 10000
+
 // rpcServer is the main RPC server for the EOTS daemon that handles
 // gRPC incoming requests.
 type rpcServer struct {
 	proto.UnimplementedEOTSManagerServer
 
-	em eotsmanager.EOTSManager
+	em     *eotsmanager.LocalEOTSManager
+	logger *zap.Logger
+	// freezeFile is the path of the sentinel file freezing the EOTS manager, empty if disabled
+	freezeFile string
 }
 
 // newRPCServer creates a new RPC sever from the set of input dependencies.
 func newRPCServer(
-	em eotsmanager.EOTSManager,
+	em *eotsmanager.LocalEOTSManager,
+	logger *zap.Logger,
+	freezeFile string,
 ) *rpcServer {
 
 	return &rpcServer{
-		em: em,
+		em:         em,
+		logger:     logger,
+		freezeFile: freezeFile,
 	}
 }
 
@@ -35,8 +54,16 @@ func (r *rpcServer) RegisterWithGrpcServer(grpcServer *grpc.Server) error {
 	return nil
 }
 
+// Ping returns the version, randomness derivation scheme and capabilities of the EOTS manager
 func (r *rpcServer) Ping(ctx context.Context, req *proto.PingRequest) (*proto.PingResponse, error) {
-	return &proto.PingResponse{}, nil
+	info := r.em.Info()
+
+	return &proto.PingResponse{
+		Version:      info.Version,
+		Commit:       info.Commit,
+		RandScheme:   info.RandScheme,
+		Capabilities: info.Capabilities,
+	}, nil
 }
 
 // CreateKey generates and saves an EOTS key
@@ -44,6 +71,11 @@ func (r *rpcServer) CreateKey(ctx context.Context, req *proto.CreateKeyRequest)
 	*proto.CreateKeyResponse, error) {
 
 	pk, err := r.em.CreateKey(req.Name, req.Passphrase, req.HdPath)
+	r.audit(ctx, []*types.AuditRecord{{
+		Operation: types.AuditOperationCreateKey,
+		EOTSPkHex: hex.EncodeToString(pk),
+		KeyName:   req.Name,
+	}}, err)
 
 	if err != nil {
 		return nil, err
@@ -57,9 +89,10 @@ func (r *rpcServer) CreateRandomnessPairList(ctx context.Context, req *proto.Cre
 	*proto.CreateRandomnessPairListResponse, error) {
 
 	pubRandList, err := r.em.CreateRandomnessPairList(req.Uid, req.ChainId, req.StartHeight, req.Num, req.Passphrase)
+	r.audit(ctx, createRandomnessAuditRecords(req), err)
 
 	if err != nil {
-		return nil, err
+		return nil, toStatusErr(err)
 	}
 
 	pubRandBytesList := make([][]byte, 0, len(pubRandList))
@@ -72,21 +105,55 @@ func (r *rpcServer) CreateRandomnessPairList(ctx context.Context, req *proto.Cre
 	}, nil
 }
 
-// KeyRecord returns the key record
+// CreateRandomnessPairListStream returns a list of Schnorr randomness pairs in chunks
+func (r *rpcServer) CreateRandomnessPairListStream(req *proto.CreateRandomnessPairListRequest,
+	stream proto.EOTSManager_CreateRandomnessPairListStreamServer) error {
+
+	pubRandList, err := r.em.CreateRandomnessPairList(req.Uid, req.ChainId, req.StartHeight, req.Num, req.Passphrase)
+	r.audit(stream.Context(), createRandomnessAuditRecords(req), err)
+
+	if err != nil {
+		return toStatusErr(err)
+	}
+
+	for start := 0; start < len(pubRandList); start += pubRandChunkSize {
+		end := start + pubRandChunkSize
+		if end > len(pubRandList) {
+			end = len(pubRandList)
+		}
+
+		pubRandBytesList := make([][]byte, 0, end-start)
+		for _, p := range pubRandList[start:end] {
+			pubRandBytesList = append(pubRandBytesList, p.Bytes()[:])
+		}
+
+		chunk := &proto.CreateRandomnessPairListChunk{
+			StartHeight: req.StartHeight + uint64(start),
+			PubRandList: pubRandBytesList,
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// KeyRecord returns the key record without the private key, which never leaves
+// the EOTS manager so that the signing policy cannot be bypassed
 func (r *rpcServer) KeyRecord(ctx context.Context, req *proto.KeyRecordRequest) (
 	*proto.KeyRecordResponse, error) {
 
 	record, err := r.em.KeyRecord(req.Uid, req.Passphrase)
+	r.audit(ctx, []*types.AuditRecord{{
+		Operation: types.AuditOperationKeyRecord,
+		EOTSPkHex: hex.EncodeToString(req.Uid),
+	}}, err)
 	if err != nil {
-		return nil, err
-	}
-
-	res := &proto.KeyRecordResponse{
-		Name:       record.Name,
-		PrivateKey: record.PrivKey.Serialize(),
+		return nil, toStatusErr(err)
 	}
 
-	return res, nil
+	return &proto.KeyRecordResponse{Name: record.Name}, nil
 }
 
 // SignEOTS signs an EOTS with the EOTS private key and the relevant randomness
@@ -94,8 +161,15 @@ func (r *rpcServer) SignEOTS(ctx context.Context, req *proto.SignEOTSRequest) (
 	*proto.SignEOTSResponse, error) {
 
 	sig, err := r.em.SignEOTS(req.Uid, req.ChainId, req.Msg, req.Height, req.Passphrase)
+	r.audit(ctx, []*types.AuditRecord{{
+		Operation:  types.AuditOperationSignEOTS,
+		EOTSPkHex:  hex.EncodeToString(req.Uid),
+		ChainID:    string(req.ChainId),
+		Height:     req.Height,
+		MsgHashHex: msgHashHex(req.Msg),
+	}}, err)
 	if err != nil {
-		return nil, err
+		return nil, toStatusErr(err)
 	}
 
 	sigBytes := sig.Bytes()
@@ -103,14 +177,141 @@ func (r *rpcServer) SignEOTS(ctx context.Context, req *proto.SignEOTSRequest) (
 	return &proto.SignEOTSResponse{Sig: sigBytes[:]}, nil
 }
 
+// SignEOTSBatch signs a list of EOTS with the EOTS private key and the relevant randomness
+func (r *rpcServer) SignEOTSBatch(ctx context.Context, req *proto.SignEOTSBatchRequest) (
+	*proto.SignEOTSBatchResponse, error) {
+
+	signReqs := make([]*types.SignRequest, 0, len(req.Msgs))
+	auditRecords := make([]*types.AuditRecord, 0, len(req.Msgs))
+	for _, m := range req.Msgs {
+		signReqs = append(signReqs, &types.SignRequest{Height: m.Height, Msg: m.Msg})
+		auditRecords = append(auditRecords, &types.AuditRecord{
+			Operation:  types.AuditOperationSignEOTS,
+			EOTSPkHex:  hex.EncodeToString(req.Uid),
+			ChainID:    string(req.ChainId),
+			Height:     m.Height,
+			MsgHashHex: msgHashHex(m.Msg),
+		})
+	}
+
+	sigs, err := r.em.SignEOTSBatch(req.Uid, req.ChainId, signReqs, req.Passphrase)
+	r.audit(ctx, auditRecords, err)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	sigBytesList := make([][]byte, 0, len(sigs))
+	for _, sig := range sigs {
+		sigBytes := sig.Bytes()
+		sigBytesList = append(sigBytesList, sigBytes[:])
+	}
+
+	return &proto.SignEOTSBatchResponse{Sigs: sigBytesList}, nil
+}
+
 // SignSchnorrSig signs a Schnorr sig with the EOTS private key
 func (r *rpcServer) SignSchnorrSig(ctx context.Context, req *proto.SignSchnorrSigRequest) (
 	*proto.SignSchnorrSigResponse, error) {
 
 	sig, err := r.em.SignSchnorrSig(req.Uid, req.Msg, req.Passphrase)
+	r.audit(ctx, []*types.AuditRecord{{
+		Operation:  types.AuditOperationSignSchnorr,
+		EOTSPkHex:  hex.EncodeToString(req.Uid),
+		MsgHashHex: msgHashHex(req.Msg),
+	}}, err)
 	if err != nil {
-		return nil, err
+		return nil, toStatusErr(err)
 	}
 
 	return &proto.SignSchnorrSigResponse{Sig: sig.Serialize()}, nil
 }
+
+// SignSchnorrSigForPayload signs a Schnorr sig over the hash of a recognised payload
+func (r *rpcServer) SignSchnorrSigForPayload(ctx context.Context, req *proto.SignSchnorrSigForPayloadRequest) (
+	*proto.SignSchnorrSigResponse, error) {
+
+	payload := &types.SchnorrPayload{PoPAddress: req.PopAddress}
+	if req.PubRandCommit != nil {
+		payload.PubRandCommit = &types.PubRandCommit{
+			StartHeight: req.PubRandCommit.StartHeight,
+			NumPubRand:  req.PubRandCommit.NumPubRand,
+			Commitment:  req.PubRandCommit.Commitment,
+		}
+	}
+
+	sig, err := r.em.SignSchnorrSigForPayload(req.Uid, payload, req.Passphrase)
+	auditRecord := &types.AuditRecord{
+		Operation: types.AuditOperationSignSchnorr,
+		EOTSPkHex: hex.EncodeToString(req.Uid),
+	}
+	if msg, hashErr := payload.HashToSign(); hashErr == nil {
+		auditRecord.MsgHashHex = msgHashHex(msg)
+	}
+	r.audit(ctx, []*types.AuditRecord{auditRecord}, err)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	return &proto.SignSchnorrSigResponse{Sig: sig.Serialize()}, nil
+}
+
+// Unlock keeps the decrypted EOTS private key in memory for the given duration
+func (r *rpcServer) Unlock(ctx context.Context, req *proto.UnlockRequest) (
+	*proto.UnlockResponse, error) {
+
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	err := r.em.Unlock(req.Uid, req.Passphrase, ttl)
+	r.audit(ctx, []*types.AuditRecord{{
+		Operation: types.AuditOperationUnlock,
+		EOTSPkHex: hex.EncodeToString(req.Uid),
+	}}, err)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	return &proto.UnlockResponse{}, nil
+}
+
+// Lock wipes the unlocked EOTS private key from memory
+func (r *rpcServer) Lock(ctx context.Context, req *proto.LockRequest) (
+	*proto.LockResponse, error) {
+
+	err := r.em.Lock(req.Uid)
+	r.audit(ctx, []*types.AuditRecord{{
+		Operation: types.AuditOperationLock,
+		EOTSPkHex: hex.EncodeToString(req.Uid),
+	}}, err)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	return &proto.LockResponse{}, nil
+}
+
+func createRandomnessAuditRecords(req *proto.CreateRandomnessPairListRequest) []*types.AuditRecord {
+	return []*types.AuditRecord{{
+		Operation:  types.AuditOperationCreateRandomness,
+		EOTSPkHex:  hex.EncodeToString(req.Uid),
+		ChainID:    string(req.ChainId),
+		Height:     req.StartHeight,
+		NumPubRand: uint64(req.Num),
+	}}
+}
+
+// toStatusErr converts the signing and policy errors of the EOTS manager into gRPC status errors
+// so that the clients can recover them
+func toStatusErr(err error) error {
+	for _, typedErr := range types.SigningErrors {
+		if errors.Is(err, typedErr) {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	for _, typedErr := range types.PolicyErrors {
+		if errors.Is(err, typedErr) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+// TestCreateRandomnessPairListStream tests that the public randomness exceeding a single
+// chunk is streamed in chunks and reassembled by the client in order
+func TestCreateRandomnessPairListStream(t *testing.T) {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	cfg := config.DefaultConfigWithHomePath(homeDir)
+	db, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	em, err := eotsmanager.NewLocalEOTSManager(homeDir, cfg.KeyringBackend, db, zap.NewNop())
+	require.NoError(t, err)
+	fpPk, err := em.CreateKey("fp", "", "")
+	require.NoError(t, err)
+
+	c := newTestClient(t, em)
+
+	chainID := []byte("chain-id")
+	startHeight := uint64(100)
+	num := uint32(2*pubRandChunkSize + 1)
+	pubRandList, err := c.CreateRandomnessPairList(fpPk, chainID, startHeight, num, "")
+	require.NoError(t, err)
+
+	expected, err := em.CreateRandomnessPairList(fpPk, chainID, startHeight, num, "")
+	require.NoError(t, err)
+	require.Len(t, pubRandList, len(expected))
+	for i := range expected {
+		require.True(t, expected[i].Equals(pubRandList[i]), "public randomness at height %d", startHeight+uint64(i))
+	}
+}
+
+// TestUnlockFrozen tests that unlocking a key of the frozen EOTS manager is refused with
+// the frozen error recovered by the client
+func TestUnlockFrozen(t *testing.T) {
+	homeDir := filepath.Join(t.TempDir(), "eots-home")
+	cfg := config.DefaultConfigWithHomePath(homeDir)
+	db, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	em, err := eotsmanager.NewLocalEOTSManager(homeDir, cfg.KeyringBackend, db, zap.NewNop())
+	require.NoError(t, err)
+	fpPk, err := em.CreateKey("fp", "", "")
+	require.NoError(t, err)
+
+	c := newTestClient(t, em)
+
+	_, err = c.Freeze("maintenance")
+	require.NoError(t, err)
+	err = c.Unlock(fpPk, "", time.Minute)
+	require.ErrorIs(t, err, types.ErrFrozen)
+
+	require.NoError(t, c.Unfreeze())
+	require.NoError(t, c.Unlock(fpPk, "", time.Minute))
+	require.NoError(t, c.Lock(fpPk))
+}
+
+// newTestClient serves the EOTS manager over gRPC and returns a client connected to it,
+// which are both stopped at the end of the test
+func newTestClient(t *testing.T, em *eotsmanager.LocalEOTSManager) *client.EOTSManagerGRpcClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	require.NoError(t, newRPCServer(em, zap.NewNop(), "").RegisterWithGrpcServer(grpcServer))
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	c, err := client.NewEOTSManagerGRpcClient(lis.Addr().String(), nil, client.DefaultConfig())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, c.Close())
+	})
+
+	return c
+}
@@ -13,9 +13,13 @@ import (
 	"github.com/lightningnetwork/lnd/signal"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/health"
+	"github.com/babylonlabs-io/finality-provider/util"
 )
 
 // Server is the main daemon construct for the EOTS manager server. It handles
@@ -28,18 +32,21 @@ type Server struct {
 	logger *zap.Logger
 
 	rpcServer   *rpcServer
+	health      *health.Checker
 	db          kvdb.Backend
 	interceptor signal.Interceptor
 
+	wg   sync.WaitGroup
 	quit chan struct{}
 }
 
 // NewEOTSManagerServer creates a new server with the given config.
-func NewEOTSManagerServer(cfg *config.Config, l *zap.Logger, em eotsmanager.EOTSManager, db kvdb.Backend, sig signal.Interceptor) *Server {
+func NewEOTSManagerServer(cfg *config.Config, l *zap.Logger, em *eotsmanager.LocalEOTSManager, db kvdb.Backend, sig signal.Interceptor) *Server {
 	return &Server{
 		cfg:         cfg,
 		logger:      l,
-		rpcServer:   newRPCServer(em),
+		rpcServer:   newRPCServer(em, l, cfg.FreezeFile),
+		health:      newHealthChecker(em, db, l),
 		db:          db,
 		interceptor: sig,
 		quit:        make(chan struct{}, 1),
@@ -58,7 +65,7 @@ func (s *Server) RunUntilShutdown() error {
 	if err != nil {
 		return fmt.Errorf("failed to get prometheus address: %w", err)
 	}
-	metricsServer := metrics.Start(promAddr, s.logger)
+	metricsServer := metrics.Start(promAddr, s.health.Ready, s.cfg.Metrics.EnablePprof, s.logger)
 
 	defer func() {
 		s.logger.Info("Shutdown complete")
@@ -75,10 +82,13 @@ func (s *Server) RunUntilShutdown() error {
 		s.logger.Info("Metrics server stopped")
 	}()
 
+	s.health.Start()
+	defer s.health.Stop()
+
 	listenAddr := s.cfg.RpcListener
 	// we create listeners from the RPCListeners defined
 	// in the config.
-	lis, err := net.Listen("tcp", listenAddr)
+	lis, err := util.Listen(listenAddr, s.cfg.RPCSocket)
 	if err != nil {
 		return fmt.Errorf("failed to listen on %s: %w", listenAddr, err)
 	}
@@ -88,12 +98,23 @@ func (s *Server) RunUntilShutdown() error {
 		}
 	}()
 
-	grpcServer := grpc.NewServer()
+	grpcOpts, err := s.cfg.RPCAuth.ServerOptions()
+	if err != nil {
+		return fmt.Errorf("failed to set up the RPC authentication: %w", err)
+	}
+	// permit the keepalive pings of the clients detecting broken connections
+	grpcOpts = append(grpcOpts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             config.MinKeepaliveTime,
+		PermitWithoutStream: true,
+	}))
+	grpcServer := grpc.NewServer(grpcOpts...)
 	defer grpcServer.Stop()
 
 	if err := s.rpcServer.RegisterWithGrpcServer(grpcServer); err != nil {
 		return fmt.Errorf("failed to register gRPC server: %w", err)
 	}
+	s.health.RegisterWithGrpcServer(grpcServer)
+	reflection.Register(grpcServer)
 
 	// All the necessary components have been registered, so we can
 	// actually start listening for requests.
@@ -101,6 +122,27 @@ func (s *Server) RunUntilShutdown() error {
 		return fmt.Errorf("failed to start gRPC listener: %v", err)
 	}
 
+	if s.cfg.Gateway.Enabled() {
+		gw, err := s.startGateway()
+		if err != nil {
+			return err
+		}
+		defer gw.Stop(context.Background())
+	}
+
+	if s.cfg.AuditRetention > 0 {
+		s.wg.Add(1)
+		go s.pruneAuditLog()
+	}
+	if s.cfg.FreezeFile != "" {
+		s.wg.Add(1)
+		go s.watchFreezeFile()
+	}
+	defer func() {
+		close(s.quit)
+		s.wg.Wait()
+	}()
+
 	s.logger.Info("EOTS Manager Daemon is fully active!")
 
 	// Wait for shutdown signal from either a graceful server stop or from
@@ -0,0 +1,137 @@
+package eotsmanager
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+// ExportSigningHistory exports the signing history of all the EOTS keys, or only the given one
+// if fpPk is not empty. The high-water mark of each chain is the maximum of the stored one and
+// the highest signed height. If hwmOnly is set, the signed heights are omitted
+func (lm *LocalEOTSManager) ExportSigningHistory(fpPk []byte, hwmOnly bool) (*types.SigningHistory, error) {
+	history := &types.SigningHistory{
+		Version: types.SigningHistoryVersion,
+		Keys:    []*types.KeySigningHistory{},
+	}
+
+	keys := make(map[string]*types.KeySigningHistory)
+	chains := make(map[string]*types.ChainSigningHistory)
+	getChain := func(pk, chainID []byte) *types.ChainSigningHistory {
+		pkHex := hex.EncodeToString(pk)
+		k, ok := keys[pkHex]
+		if !ok {
+			k = &types.KeySigningHistory{EOTSPkHex: pkHex}
+			keys[pkHex] = k
+			history.Keys = append(history.Keys, k)
+		}
+		chainKey := pkHex + "/" + string(chainID)
+		c, ok := chains[chainKey]
+		if !ok {
+			c = &types.ChainSigningHistory{ChainID: string(chainID)}
+			chains[chainKey] = c
+			k.Chains = append(k.Chains, c)
+		}
+		return c
+	}
+
+	err := lm.es.ForEachSignRecord(fpPk, func(pk, chainID []byte, height uint64, record *store.SigningRecord) error {
+		c := getChain(pk, chainID)
+		if height > c.HighWaterMark {
+			c.HighWaterMark = height
+		}
+		if hwmOnly {
+			return nil
+		}
+		c.Records = append(c.Records, &types.SignedHeight{
+			Height:       height,
+			MsgHashHex:   hex.EncodeToString(record.MsgHash),
+			SignatureHex: hex.EncodeToString(record.Signature),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing records: %w", err)
+	}
+
+	err = lm.es.ForEachHighWaterMark(fpPk, func(pk, chainID []byte, hwm uint64) error {
+		c := getChain(pk, chainID)
+		if hwm > c.HighWaterMark {
+			c.HighWaterMark = hwm
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read high-water marks: %w", err)
+	}
+
+	return history, nil
+}
+
+// ImportSigningHistory conservatively merges the given signing history into the db so that
+// the manager never signs new messages at or below the imported heights. Existing signing
+// records are never overwritten, and conflicting records are reported in the result
+func (lm *LocalEOTSManager) ImportSigningHistory(history *types.SigningHistory) ([]*types.SigningHistoryImportResult, error) {
+	if err := history.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid signing history: %w", err)
+	}
+
+	results := make([]*types.SigningHistoryImportResult, 0)
+	for _, k := range history.Keys {
+		pk, err := hex.DecodeString(k.EOTSPkHex)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range k.Chains {
+			records := make(map[uint64]*store.SigningRecord, len(c.Records))
+			for _, r := range c.Records {
+				msgHash, err := hex.DecodeString(r.MsgHashHex)
+				if err != nil {
+					return nil, err
+				}
+				sig, err := hex.DecodeString(r.SignatureHex)
+				if err != nil {
+					return nil, err
+				}
+				records[r.Height] = &store.SigningRecord{
+					MsgHash:   msgHash,
+					Signature: sig,
+				}
+			}
+
+			conflicts, err := lm.es.MergeSigningHistory(pk, []byte(c.ChainID), c.HighWaterMark, records)
+			if err != nil {
+				return nil, fmt.Errorf("failed to merge signing history of %s on chain %s: %w",
+					k.EOTSPkHex, c.ChainID, err)
+			}
+			if len(conflicts) != 0 {
+				lm.logger.Error(
+					"the imported signing history conflicts with existing signing records",
+					zap.String("pk", k.EOTSPkHex),
+					zap.String("chain_id", c.ChainID),
+					zap.Uint64s("heights", conflicts),
+				)
+			}
+
+			hwm, err := lm.es.GetHighWaterMark(pk, []byte(c.ChainID))
+			if err != nil {
+				return nil, err
+			}
+
+			results = append(results, &types.SigningHistoryImportResult{
+				EOTSPkHex:     k.EOTSPkHex,
+				ChainID:       c.ChainID,
+				HighWaterMark: hwm,
+				NumRecords:    len(records),
+				Conflicts:     conflicts,
+			})
+		}
+	}
+
+	return results, nil
+}
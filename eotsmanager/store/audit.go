@@ -0,0 +1,146 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lightningnetwork/lnd/kvdb"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+var (
+	// mapping: sequence number -> AuditRecord
+	auditLogBucketName = []byte("auditLog")
+)
+
+// AppendAuditRecords appends the given records to the audit log in a single
+// transaction, assigning each of them the next sequence number as its ID
+func (s *EOTSStore) AppendAuditRecords(records []*types.AuditRecord) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		auditBucket := tx.ReadWriteBucket(auditLogBucketName)
+		if auditBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		for _, r := range records {
+			id, err := auditBucket.NextSequence()
+			if err != nil {
+				return err
+			}
+			r.ID = id
+
+			v, err := json.Marshal(r)
+			if err != nil {
+				return err
+			}
+			if err := auditBucket.Put(sdk.Uint64ToBigEndian(id), v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ListAuditRecords returns at most limit audit records matching the filter, in
+// the order they were appended, starting from the record of ID startID. The ID of
+// the next matching record is returned for pagination, which is zero if there is none
+func (s *EOTSStore) ListAuditRecords(filter *types.AuditFilter, startID uint64, limit uint64) ([]*types.AuditRecord, uint64, error) {
+	var (
+		records []*types.AuditRecord
+		nextID  uint64
+	)
+	err := s.db.View(func(tx kvdb.RTx) error {
+		auditBucket := tx.ReadBucket(auditLogBucketName)
+		if auditBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		c := auditBucket.ReadCursor()
+		for k, v := c.Seek(sdk.Uint64ToBigEndian(startID)); k != nil; k, v = c.Next() {
+			r, err := unmarshalAuditRecord(k, v)
+			if err != nil {
+				return err
+			}
+			if !filter.Match(r) {
+				continue
+			}
+			if uint64(len(records)) == limit {
+				nextID = r.ID
+				return nil
+			}
+			records = append(records, r)
+		}
+
+		return nil
+	}, func() {
+		records = nil
+		nextID = 0
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return records, nextID, nil
+}
+
+// PruneAuditRecords deletes the audit records older than the given time, and
+// returns the number of the deleted records
+func (s *EOTSStore) PruneAuditRecords(before time.Time) (int, error) {
+	var numPruned int
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		numPruned = 0
+
+		auditBucket := tx.ReadWriteBucket(auditLogBucketName)
+		if auditBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		// the records are appended in time order, so the old records are at the front
+		var toDelete [][]byte
+		c := auditBucket.ReadCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			r, err := unmarshalAuditRecord(k, v)
+			if err != nil {
+				return err
+			}
+			if !r.Timestamp.Before(before) {
+				break
+			}
+			toDelete = append(toDelete, append([]byte{}, k...))
+		}
+
+		for _, k := range toDelete {
+			if err := auditBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		numPruned = len(toDelete)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
+func unmarshalAuditRecord(k, v []byte) (*types.AuditRecord, error) {
+	if len(k) != 8 {
+		return nil, fmt.Errorf("%w: invalid audit record key", ErrCorruptedEOTSDb)
+	}
+
+	var r types.AuditRecord
+	if err := json.Unmarshal(v, &r); err != nil {
+		return nil, fmt.Errorf("%w: invalid audit record %d: %v", ErrCorruptedEOTSDb, binary.BigEndian.Uint64(k), err)
+	}
+
+	return &r, nil
+}
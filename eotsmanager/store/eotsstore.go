@@ -33,6 +33,26 @@ func (s *EOTSStore) initBuckets() error {
 			return err
 		}
 
+		_, err = tx.CreateTopLevelBucket(signRecordBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(highWaterMarkBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(auditLogBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(freezeBucketName)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -93,3 +113,19 @@ func (s *EOTSStore) GetEOTSKeyName(pk []byte) (string, error) {
 
 	return keyName, nil
 }
+
+// DeleteEOTSKeyName removes the mapping of the given public key to its key name
+func (s *EOTSStore) DeleteEOTSKeyName(pk []byte) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		eotsBucket := tx.ReadWriteBucket(eotsBucketName)
+		if eotsBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		if eotsBucket.Get(pk) == nil {
+			return ErrEOTSKeyNameNotFound
+		}
+
+		return eotsBucket.Delete(pk)
+	})
+}
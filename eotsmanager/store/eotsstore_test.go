@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/config"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/store"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 	"github.com/babylonlabs-io/finality-provider/testutil"
 )
 
@@ -63,3 +65,159 @@ func FuzzEOTSStore(f *testing.F) {
 		require.ErrorIs(t, err, store.ErrEOTSKeyNameNotFound)
 	})
 }
+
+// FuzzSignRecordStore tests save and get signing records properly
+func FuzzSignRecordStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+
+		vs, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		pk := schnorr.SerializePubKey(btcPk)
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 1000)
+		msgHash := datagen.GenRandomByteArray(r, 32)
+		sig := datagen.GenRandomByteArray(r, 32)
+
+		_, err = vs.GetSignRecord(pk, chainID, height)
+		require.ErrorIs(t, err, store.ErrSignRecordNotFound)
+
+		records := []*store.SigningRecord{{MsgHash: msgHash, Signature: sig}}
+		err = vs.SaveSignRecords(pk, chainID, []uint64{height}, records)
+		require.NoError(t, err)
+
+		record, err := vs.GetSignRecord(pk, chainID, height)
+		require.NoError(t, err)
+		require.Equal(t, msgHash, record.MsgHash)
+		require.Equal(t, sig, record.Signature)
+
+		// saving the same record again is a no-op
+		err = vs.SaveSignRecords(pk, chainID, []uint64{height}, records)
+		require.NoError(t, err)
+
+		// the record cannot be overwritten, and nothing in the batch is saved
+		conflicting := []*store.SigningRecord{
+			{MsgHash: datagen.GenRandomByteArray(r, 32)},
+			{MsgHash: datagen.GenRandomByteArray(r, 32), Signature: sig},
+		}
+		err = vs.SaveSignRecords(pk, chainID, []uint64{height + 1, height}, conflicting)
+		require.ErrorIs(t, err, store.ErrConflictingSignRecord)
+		record, err = vs.GetSignRecord(pk, chainID, height)
+		require.NoError(t, err)
+		require.Equal(t, msgHash, record.MsgHash)
+
+		// records are separated by chain and height
+		_, err = vs.GetSignRecord(pk, datagen.GenRandomByteArray(r, 11), height)
+		require.ErrorIs(t, err, store.ErrSignRecordNotFound)
+		_, err = vs.GetSignRecord(pk, chainID, height+1)
+		require.ErrorIs(t, err, store.ErrSignRecordNotFound)
+	})
+}
+
+// FuzzAuditLogStore tests the audit records are appended, listed by page with
+// filters, and pruned by their timestamps
+func FuzzAuditLogStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+
+		vs, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		chainIDs := []string{testutil.GenRandomHexStr(r, 4), testutil.GenRandomHexStr(r, 4)}
+		startTime := time.Unix(int64(datagen.RandomInt(r, 1000000)), 0).UTC()
+		num := r.Intn(50) + 1
+		records := make([]*types.AuditRecord, 0, num)
+		numChain0 := 0
+		for i := 0; i < num; i++ {
+			chainID := chainIDs[r.Intn(2)]
+			if chainID == chainIDs[0] {
+				numChain0++
+			}
+			records = append(records, &types.AuditRecord{
+				Timestamp: startTime.Add(time.Duration(i) * time.Minute),
+				Operation: types.AuditOperationSignEOTS,
+				ChainID:   chainID,
+				Height:    uint64(i) + 1,
+				Outcome:   types.AuditOutcomeSuccess,
+			})
+		}
+		err = vs.AppendAuditRecords(records)
+		require.NoError(t, err)
+		for i, record := range records {
+			require.Equal(t, uint64(i)+1, record.ID)
+		}
+
+		// list the records of a chain page by page
+		filter := &types.AuditFilter{ChainID: chainIDs[0]}
+		limit := datagen.RandomInt(r, 5) + 1
+		var (
+			listed  []*types.AuditRecord
+			pageKey uint64
+		)
+		for {
+			page, nextPageKey, err := vs.ListAuditRecords(filter, pageKey, limit)
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(page)), limit)
+			listed = append(listed, page...)
+			if nextPageKey == 0 {
+				break
+			}
+			pageKey = nextPageKey
+		}
+		require.Len(t, listed, numChain0)
+		for i, record := range listed {
+			require.Equal(t, chainIDs[0], record.ChainID)
+			if i > 0 {
+				require.Greater(t, record.ID, listed[i-1].ID)
+			}
+		}
+
+		// prune the records older than a random record
+		numPruned := r.Intn(num + 1)
+		pruned, err := vs.PruneAuditRecords(startTime.Add(time.Duration(numPruned) * time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, numPruned, pruned)
+		remaining, nextPageKey, err := vs.ListAuditRecords(&types.AuditFilter{}, 0, uint64(num))
+		require.NoError(t, err)
+		require.Zero(t, nextPageKey)
+		require.Len(t, remaining, num-numPruned)
+		if len(remaining) > 0 {
+			require.Equal(t, uint64(numPruned)+1, remaining[0].ID)
+		}
+
+		// the IDs keep increasing after pruning
+		newRecord := &types.AuditRecord{Timestamp: time.Now(), Outcome: types.AuditOutcomeSuccess}
+		err = vs.AppendAuditRecords([]*types.AuditRecord{newRecord})
+		require.NoError(t, err)
+		require.Equal(t, uint64(num)+1, newRecord.ID)
+	})
+}
@@ -11,4 +11,16 @@ var (
 
 	// ErrEOTSKeyNameNotFound The EOTS key name we try to fetch is not found in db
 	ErrEOTSKeyNameNotFound = errors.New("EOTS key name not found")
+
+	// ErrConflictingSignRecord A signing record of a different message already exists in db
+	ErrConflictingSignRecord = errors.New("a signing record of a different message already exists")
+
+	// ErrSignRecordNotFound The signing record we try to fetch is not found in db
+	ErrSignRecordNotFound = errors.New("signing record not found")
+
+	// ErrBelowHighWaterMark The height we try to sign at is not above the signing high-water mark
+	ErrBelowHighWaterMark = errors.New("height is at or below the signing high-water mark")
+
+	// ErrFreezeStateNotFound The EOTS manager is not frozen
+	ErrFreezeStateNotFound = errors.New("freeze state not found")
 )
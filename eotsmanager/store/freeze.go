@@ -0,0 +1,80 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/types"
+)
+
+var (
+	// mapping: freezeStateKey -> FreezeState
+	freezeBucketName = []byte("freeze")
+
+	freezeStateKey = []byte("state")
+)
+
+// SaveFreezeState persists the freeze state, which freezes the EOTS manager until
+// the state is deleted
+func (s *EOTSStore) SaveFreezeState(state *types.FreezeState) error {
+	v, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		freezeBucket := tx.ReadWriteBucket(freezeBucketName)
+		if freezeBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return freezeBucket.Put(freezeStateKey, v)
+	})
+}
+
+// GetFreezeState returns the persisted freeze state
+// It fails with ErrFreezeStateNotFound if the EOTS manager is not frozen
+func (s *EOTSStore) GetFreezeState() (*types.FreezeState, error) {
+	var state *types.FreezeState
+	err := s.db.View(func(tx kvdb.RTx) error {
+		freezeBucket := tx.ReadBucket(freezeBucketName)
+		if freezeBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		v := freezeBucket.Get(freezeStateKey)
+		if v == nil {
+			return ErrFreezeStateNotFound
+		}
+
+		state = &types.FreezeState{}
+		if err := json.Unmarshal(v, state); err != nil {
+			return fmt.Errorf("%w: invalid freeze state: %v", ErrCorruptedEOTSDb, err)
+		}
+
+		return nil
+	}, func() {
+		state = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// DeleteFreezeState deletes the persisted freeze state, which unfreezes the EOTS manager
+// It is a no-op if the EOTS manager is not frozen
+func (s *EOTSStore) DeleteFreezeState() error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		freezeBucket := tx.ReadWriteBucket(freezeBucketName)
+		if freezeBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return freezeBucket.Delete(freezeStateKey)
+	})
+}
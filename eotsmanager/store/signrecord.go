@@ -0,0 +1,437 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+const (
+	// msgHashSize is the size of the sha256 hash of a signed message
+	msgHashSize = 32
+	// eotsSigSize is the size of a serialized EOTS signature
+	eotsSigSize = 32
+)
+
+var (
+	// mapping: pk -> chainID -> height -> SigningRecord
+	signRecordBucketName = []byte("signRecords")
+
+	// mapping: pk -> chainID -> high-water mark height
+	highWaterMarkBucketName = []byte("signHighWaterMarks")
+)
+
+// SigningRecord is the record of an EOTS signature produced by the EOTS manager
+// at a certain height of a certain chain
+type SigningRecord struct {
+	// MsgHash is the sha256 hash of the signed message
+	MsgHash []byte
+	// Signature is the serialized EOTS signature
+	// It can be empty if the record is imported without the signature
+	Signature []byte
+}
+
+func (r *SigningRecord) marshal() []byte {
+	return append(append([]byte{}, r.MsgHash...), r.Signature...)
+}
+
+func (r *SigningRecord) validate() error {
+	if len(r.MsgHash) != msgHashSize {
+		return fmt.Errorf("invalid message hash length %d", len(r.MsgHash))
+	}
+	if len(r.Signature) != 0 && len(r.Signature) != eotsSigSize {
+		return fmt.Errorf("invalid signature length %d", len(r.Signature))
+	}
+
+	return nil
+}
+
+func unmarshalSigningRecord(v []byte) (*SigningRecord, error) {
+	if len(v) != msgHashSize && len(v) != msgHashSize+eotsSigSize {
+		return nil, fmt.Errorf("%w: invalid signing record length %d", ErrCorruptedEOTSDb, len(v))
+	}
+
+	record := &SigningRecord{
+		MsgHash: append([]byte{}, v[:msgHashSize]...),
+	}
+	if len(v) > msgHashSize {
+		record.Signature = append([]byte{}, v[msgHashSize:]...)
+	}
+
+	return record, nil
+}
+
+// SaveSignRecords saves the signing records of the given key at the given chain and heights
+// in a single transaction. Heights that already have a record of the same message are skipped.
+// It fails with ErrConflictingSignRecord if a record of a different message exists at any of
+// the heights, or with ErrBelowHighWaterMark if any new record is not above the high-water mark
+// of the key and chain. Nothing is saved if it fails
+func (s *EOTSStore) SaveSignRecords(
+	pk []byte,
+	chainID []byte,
+	heights []uint64,
+	records []*SigningRecord,
+) error {
+	if len(chainID) == 0 {
+		return fmt.Errorf("cannot save signing records with empty chain ID")
+	}
+	if len(heights) != len(records) {
+		return fmt.Errorf("the number of heights %d and signing records %d mismatch", len(heights), len(records))
+	}
+
+	for i, record := range records {
+		if err := record.validate(); err != nil {
+			return fmt.Errorf("invalid signing record at height %d: %w", heights[i], err)
+		}
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		hwm, err := getHighWaterMark(tx, pk, chainID)
+		if err != nil {
+			return err
+		}
+
+		chainBucket, err := signRecordChainBucket(tx, pk, chainID)
+		if err != nil {
+			return err
+		}
+
+		for i, height := range heights {
+			heightKey := sdk.Uint64ToBigEndian(height)
+			if existing := chainBucket.Get(heightKey); existing != nil {
+				existingRecord, err := unmarshalSigningRecord(existing)
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(existingRecord.MsgHash, records[i].MsgHash) {
+					return fmt.Errorf("%w: height %d", ErrConflictingSignRecord, height)
+				}
+				continue
+			}
+
+			if hwm != 0 && height <= hwm {
+				return fmt.Errorf("%w: height %d, high-water mark %d", ErrBelowHighWaterMark, height, hwm)
+			}
+
+			if err := chainBucket.Put(heightKey, records[i].marshal()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetSignRecord returns the signing record of the given key at the given chain and height
+// It fails with ErrSignRecordNotFound if the key has not signed at the height
+func (s *EOTSStore) GetSignRecord(pk []byte, chainID []byte, height uint64) (*SigningRecord, error) {
+	var record *SigningRecord
+	err := s.db.View(func(tx kvdb.RTx) error {
+		signRecordBucket := tx.ReadBucket(signRecordBucketName)
+		if signRecordBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		pkBucket := signRecordBucket.NestedReadBucket(pk)
+		if pkBucket == nil {
+			return ErrSignRecordNotFound
+		}
+
+		chainBucket := pkBucket.NestedReadBucket(chainID)
+		if chainBucket == nil {
+			return ErrSignRecordNotFound
+		}
+
+		v := chainBucket.Get(sdk.Uint64ToBigEndian(height))
+		if v == nil {
+			return ErrSignRecordNotFound
+		}
+
+		var err error
+		record, err = unmarshalSigningRecord(v)
+		return err
+	}, func() {})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// GetHighWaterMark returns the height at or below which the given key must not
+// sign new messages on the given chain. Zero means no high-water mark is set
+func (s *EOTSStore) GetHighWaterMark(pk []byte, chainID []byte) (uint64, error) {
+	var hwm uint64
+	err := s.db.View(func(tx kvdb.RTx) error {
+		var err error
+		hwm, err = getHighWaterMark(tx, pk, chainID)
+		return err
+	}, func() {})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return hwm, nil
+}
+
+// GetLastSignedHeight returns the highest height at which the given key has signed on the
+// given chain, taking the high-water mark into account. Zero means the key has not signed
+func (s *EOTSStore) GetLastSignedHeight(pk []byte, chainID []byte) (uint64, error) {
+	var lastHeight uint64
+	err := s.db.View(func(tx kvdb.RTx) error {
+		hwm, err := getHighWaterMark(tx, pk, chainID)
+		if err != nil {
+			return err
+		}
+		lastHeight = hwm
+
+		signRecordBucket := tx.ReadBucket(signRecordBucketName)
+		if signRecordBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+		pkBucket := signRecordBucket.NestedReadBucket(pk)
+		if pkBucket == nil {
+			return nil
+		}
+		chainBucket := pkBucket.NestedReadBucket(chainID)
+		if chainBucket == nil {
+			return nil
+		}
+
+		// the heights are big endian encoded, so the last key is the highest height
+		k, _ := chainBucket.ReadCursor().Last()
+		if k == nil {
+			return nil
+		}
+		if len(k) != 8 {
+			return fmt.Errorf("%w: invalid signing record height key", ErrCorruptedEOTSDb)
+		}
+		if height := binary.BigEndian.Uint64(k); height > lastHeight {
+			lastHeight = height
+		}
+
+		return nil
+	}, func() {})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return lastHeight, nil
+}
+
+// ForEachSignRecord iterates over all the signing records ordered by key, chain and height
+// If pk is not empty, only the records of the given key are visited
+func (s *EOTSStore) ForEachSignRecord(
+	pk []byte,
+	fn func(pk, chainID []byte, height uint64, record *SigningRecord) error,
+) error {
+	return s.db.View(func(tx kvdb.RTx) error {
+		signRecordBucket := tx.ReadBucket(signRecordBucketName)
+		if signRecordBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return forEachNested(signRecordBucket, pk, func(pkBytes, chainID []byte, chainBucket kvdb.RBucket) error {
+			return chainBucket.ForEach(func(k, v []byte) error {
+				if len(k) != 8 {
+					return fmt.Errorf("%w: invalid signing record height key", ErrCorruptedEOTSDb)
+				}
+				record, err := unmarshalSigningRecord(v)
+				if err != nil {
+					return err
+				}
+
+				return fn(pkBytes, chainID, binary.BigEndian.Uint64(k), record)
+			})
+		})
+	}, func() {})
+}
+
+// ForEachHighWaterMark iterates over all the high-water marks ordered by key and chain
+// If pk is not empty, only the high-water marks of the given key are visited
+func (s *EOTSStore) ForEachHighWaterMark(
+	pk []byte,
+	fn func(pk, chainID []byte, hwm uint64) error,
+) error {
+	return s.db.View(func(tx kvdb.RTx) error {
+		hwmBucket := tx.ReadBucket(highWaterMarkBucketName)
+		if hwmBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return forEachPkBucket(hwmBucket, pk, func(pkBytes []byte, pkBucket kvdb.RBucket) error {
+			return pkBucket.ForEach(func(k, v []byte) error {
+				if len(v) != 8 {
+					return fmt.Errorf("%w: invalid high-water mark", ErrCorruptedEOTSDb)
+				}
+
+				return fn(pkBytes, append([]byte{}, k...), binary.BigEndian.Uint64(v))
+			})
+		})
+	}, func() {})
+}
+
+// MergeSigningHistory conservatively merges a signing history of the given key and chain
+// into the db in a single transaction. Records at heights that have no record yet are
+// added, existing records are never overwritten, and the high-water mark is raised to the
+// maximum of the current one, the given one and the highest merged height.
+// It returns the heights at which the merged records conflict with the existing ones
+func (s *EOTSStore) MergeSigningHistory(
+	pk []byte,
+	chainID []byte,
+	hwm uint64,
+	records map[uint64]*SigningRecord,
+) ([]uint64, error) {
+	if len(chainID) == 0 {
+		return nil, fmt.Errorf("cannot merge signing history with empty chain ID")
+	}
+
+	for height, record := range records {
+		if err := record.validate(); err != nil {
+			return nil, fmt.Errorf("invalid signing record at height %d: %w", height, err)
+		}
+		if height > hwm {
+			hwm = height
+		}
+	}
+
+	var conflicts []uint64
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		// reset in case the batch is retried
+		conflicts = nil
+
+		chainBucket, err := signRecordChainBucket(tx, pk, chainID)
+		if err != nil {
+			return err
+		}
+
+		for height, record := range records {
+			heightKey := sdk.Uint64ToBigEndian(height)
+			existing := chainBucket.Get(heightKey)
+			if existing == nil {
+				if err := chainBucket.Put(heightKey, record.marshal()); err != nil {
+					return err
+				}
+				continue
+			}
+
+			existingRecord, err := unmarshalSigningRecord(existing)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(existingRecord.MsgHash, record.MsgHash) {
+				conflicts = append(conflicts, height)
+			}
+		}
+
+		currentHwm, err := getHighWaterMark(tx, pk, chainID)
+		if err != nil {
+			return err
+		}
+		if hwm <= currentHwm {
+			return nil
+		}
+
+		hwmBucket := tx.ReadWriteBucket(highWaterMarkBucketName)
+		if hwmBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+		pkBucket, err := hwmBucket.CreateBucketIfNotExists(pk)
+		if err != nil {
+			return err
+		}
+
+		return pkBucket.Put(chainID, sdk.Uint64ToBigEndian(hwm))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i] < conflicts[j] })
+
+	return conflicts, nil
+}
+
+func signRecordChainBucket(tx kvdb.RwTx, pk []byte, chainID []byte) (kvdb.RwBucket, error) {
+	signRecordBucket := tx.ReadWriteBucket(signRecordBucketName)
+	if signRecordBucket == nil {
+		return nil, ErrCorruptedEOTSDb
+	}
+
+	pkBucket, err := signRecordBucket.CreateBucketIfNotExists(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return pkBucket.CreateBucketIfNotExists(chainID)
+}
+
+func getHighWaterMark(tx kvdb.RTx, pk []byte, chainID []byte) (uint64, error) {
+	hwmBucket := tx.ReadBucket(highWaterMarkBucketName)
+	if hwmBucket == nil {
+		return 0, ErrCorruptedEOTSDb
+	}
+
+	pkBucket := hwmBucket.NestedReadBucket(pk)
+	if pkBucket == nil {
+		return 0, nil
+	}
+
+	v := pkBucket.Get(chainID)
+	if v == nil {
+		return 0, nil
+	}
+	if len(v) != 8 {
+		return 0, fmt.Errorf("%w: invalid high-water mark", ErrCorruptedEOTSDb)
+	}
+
+	return binary.BigEndian.Uint64(v), nil
+}
+
+// forEachPkBucket visits the nested bucket of every key, or only the given one if pk is not empty
+func forEachPkBucket(
+	bucket kvdb.RBucket,
+	pk []byte,
+	fn func(pk []byte, pkBucket kvdb.RBucket) error,
+) error {
+	if len(pk) != 0 {
+		pkBucket := bucket.NestedReadBucket(pk)
+		if pkBucket == nil {
+			return nil
+		}
+		return fn(pk, pkBucket)
+	}
+
+	return bucket.ForEach(func(k, _ []byte) error {
+		pkBucket := bucket.NestedReadBucket(k)
+		if pkBucket == nil {
+			return fmt.Errorf("%w: unexpected value in the key bucket", ErrCorruptedEOTSDb)
+		}
+		return fn(append([]byte{}, k...), pkBucket)
+	})
+}
+
+// forEachNested visits the chain buckets nested under the key buckets
+func forEachNested(
+	bucket kvdb.RBucket,
+	pk []byte,
+	fn func(pk, chainID []byte, chainBucket kvdb.RBucket) error,
+) error {
+	return forEachPkBucket(bucket, pk, func(pkBytes []byte, pkBucket kvdb.RBucket) error {
+		return pkBucket.ForEach(func(k, _ []byte) error {
+			chainBucket := pkBucket.NestedReadBucket(k)
+			if chainBucket == nil {
+				return fmt.Errorf("%w: unexpected value in the chain bucket", ErrCorruptedEOTSDb)
+			}
+			return fn(pkBytes, append([]byte{}, k...), chainBucket)
+		})
+	})
+}
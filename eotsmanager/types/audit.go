@@ -0,0 +1,81 @@
+package types
+
+import (
+	"time"
+)
+
+// the operations recorded in the audit log
+const (
+	AuditOperationCreateKey        = "create_key"
+	AuditOperationCreateRandomness = "create_randomness"
+	AuditOperationSignEOTS         = "sign_eots"
+	AuditOperationSignSchnorr      = "sign_schnorr"
+	AuditOperationFreeze           = "freeze"
+	AuditOperationUnfreeze         = "unfreeze"
+	AuditOperationKeyRecord        = "key_record"
+	AuditOperationUnlock           = "unlock"
+	AuditOperationLock             = "lock"
+)
+
+// the outcomes of the operations recorded in the audit log
+const (
+	AuditOutcomeSuccess = "success"
+	// AuditOutcomeRejected is the outcome of the requests refused by the
+	// safety checks or the signing policy of the EOTS manager
+	AuditOutcomeRejected = "rejected"
+	AuditOutcomeFailed   = "failed"
+)
+
+// AuditRecord is an entry of the append-only audit log of the EOTS manager
+type AuditRecord struct {
+	// ID is the sequence number of the record, which increases with every record
+	ID        uint64    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Operation string    `json:"operation"`
+	// EOTSPkHex is the hex of the BIP-340 EOTS public key
+	EOTSPkHex string `json:"eots_pk_hex,omitempty"`
+	// KeyName is the name of the created key
+	KeyName string `json:"key_name,omitempty"`
+	ChainID string `json:"chain_id,omitempty"`
+	// Height is the signed height, or the start height of the created randomness
+	Height uint64 `json:"height,omitempty"`
+	// NumPubRand is the number of the created randomness
+	NumPubRand uint64 `json:"num_pub_rand,omitempty"`
+	// MsgHashHex is the hex of the sha256 hash of the signed message
+	MsgHashHex string `json:"msg_hash_hex,omitempty"`
+	// Peer is the address of the caller
+	Peer    string `json:"peer,omitempty"`
+	Outcome string `json:"outcome"`
+	Error   string `json:"error,omitempty"`
+}
+
+// AuditFilter selects the audit records matching all of its non-empty fields
+type AuditFilter struct {
+	EOTSPkHex string
+	ChainID   string
+	Operation string
+	Outcome   string
+	// StartTime and EndTime bound the timestamps of the records, inclusive
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// Match returns whether the record matches the filter
+func (f *AuditFilter) Match(r *AuditRecord) bool {
+	switch {
+	case f.EOTSPkHex != "" && f.EOTSPkHex != r.EOTSPkHex:
+		return false
+	case f.ChainID != "" && f.ChainID != r.ChainID:
+		return false
+	case f.Operation != "" && f.Operation != r.Operation:
+		return false
+	case f.Outcome != "" && f.Outcome != r.Outcome:
+		return false
+	case !f.StartTime.IsZero() && r.Timestamp.Before(f.StartTime):
+		return false
+	case !f.EndTime.IsZero() && r.Timestamp.After(f.EndTime):
+		return false
+	default:
+		return true
+	}
+}
@@ -4,4 +4,27 @@ import "errors"
 
 var (
 	ErrFinalityProviderAlreadyExisted = errors.New("the finality provider has already existed")
+	ErrDoubleSign                     = errors.New("refusing to sign a different message at an already signed height")
+	ErrBelowHighWaterMark             = errors.New("refusing to sign at or below the signing high-water mark")
+	ErrFrozen                         = errors.New("refusing to sign while the EOTS manager is frozen")
+
+	ErrChainNotAllowed          = errors.New("refusing to sign on a chain not allowed by the signing policy")
+	ErrHeightJumpTooLarge       = errors.New("refusing to sign too far above the last signed height by the signing policy")
+	ErrHeightBelowFloor         = errors.New("refusing to sign below the height floor of the signing policy")
+	ErrSchnorrPayloadNotAllowed = errors.New("refusing to sign an unrecognised Schnorr payload by the signing policy")
 )
+
+// SigningErrors are the errors returned when the EOTS manager refuses to sign
+var SigningErrors = []error{
+	ErrDoubleSign,
+	ErrBelowHighWaterMark,
+	ErrFrozen,
+}
+
+// PolicyErrors are the errors returned when the signing policy refuses to sign
+var PolicyErrors = []error{
+	ErrChainNotAllowed,
+	ErrHeightJumpTooLarge,
+	ErrHeightBelowFloor,
+	ErrSchnorrPayloadNotAllowed,
+}
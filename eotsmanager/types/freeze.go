@@ -0,0 +1,10 @@
+package types
+
+import "time"
+
+// FreezeState is the state of a frozen EOTS manager, which refuses to sign until it is unfrozen
+type FreezeState struct {
+	// Reason is the reason given by the operator who froze the EOTS manager
+	Reason   string    `json:"reason,omitempty"`
+	FrozenAt time.Time `json:"frozen_at"`
+}
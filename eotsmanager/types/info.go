@@ -0,0 +1,46 @@
+package types
+
+// The capabilities of an EOTS manager, each of which is the support of an RPC or a
+// behaviour that the clients may rely on
+const (
+	CapabilityRandomnessStream = "randomness-stream"
+	CapabilitySignEOTSBatch    = "sign-eots-batch"
+	CapabilitySchnorrPayload   = "schnorr-payload"
+	CapabilitySigningPolicy    = "signing-policy"
+	CapabilityUnlock           = "unlock"
+	CapabilityAuditLog         = "audit-log"
+	CapabilityFreeze           = "freeze"
+)
+
+// Capabilities is the list of the capabilities of this build of the EOTS manager
+var Capabilities = []string{
+	CapabilityRandomnessStream,
+	CapabilitySignEOTSBatch,
+	CapabilitySchnorrPayload,
+	CapabilitySigningPolicy,
+	CapabilityUnlock,
+	CapabilityAuditLog,
+	CapabilityFreeze,
+}
+
+// ServerInfo describes the build of an EOTS manager, which its clients check for compatibility
+type ServerInfo struct {
+	// Version is the semantic version of the EOTS manager, empty if it predates the handshake
+	Version string `json:"version"`
+	// Commit is the commit the EOTS manager is built from
+	Commit string `json:"commit,omitempty"`
+	// RandScheme identifies how the EOTS manager derives the randomness
+	RandScheme   string   `json:"rand_scheme"`
+	Capabilities []string `json:"capabilities"`
+}
+
+// HasCapability returns whether the EOTS manager has the capability
+func (i *ServerInfo) HasCapability(capability string) bool {
+	for _, c := range i.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SchnorrPayload is a payload recognised by the EOTS manager, of which the hash
+// is signed with a Schnorr signature. Exactly one of the fields should be set
+type SchnorrPayload struct {
+	// PubRandCommit is a commit of public randomness
+	PubRandCommit *PubRandCommit
+	// PoPAddress is the address of the finality provider on the consumer chain,
+	// of which the proof of possession is signed
+	PoPAddress []byte
+}
+
+// PubRandCommit is a commit of public randomness from StartHeight to
+// StartHeight+(NumPubRand-1) to the consumer chain
+type PubRandCommit struct {
+	StartHeight uint64
+	NumPubRand  uint64
+	Commitment  []byte
+}
+
+// HashToSign returns the hash of the payload to be signed
+func (p *SchnorrPayload) HashToSign() ([]byte, error) {
+	switch {
+	case p.PubRandCommit != nil && len(p.PoPAddress) == 0:
+		return GetHashToSignForCommitPubRand(p.PubRandCommit.StartHeight, p.PubRandCommit.NumPubRand, p.PubRandCommit.Commitment)
+	case p.PubRandCommit == nil && len(p.PoPAddress) != 0:
+		return tmhash.Sum(p.PoPAddress), nil
+	default:
+		return nil, fmt.Errorf("exactly one of the public randomness commit and the PoP address should be set")
+	}
+}
+
+// GetHashToSignForCommitPubRand returns the hash of a commit of public randomness to be signed
+// TODO: have this function in Babylon side
+func GetHashToSignForCommitPubRand(startHeight uint64, numPubRand uint64, commitment []byte) ([]byte, error) {
+	hasher := tmhash.New()
+	if _, err := hasher.Write(sdk.Uint64ToBigEndian(startHeight)); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(sdk.Uint64ToBigEndian(numPubRand)); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(commitment); err != nil {
+		return nil, err
+	}
+	return hasher.Sum(nil), nil
+}
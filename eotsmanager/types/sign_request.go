@@ -0,0 +1,7 @@
+package types
+
+// SignRequest is a message to be signed by EOTS at a certain height
+type SignRequest struct {
+	Height uint64
+	Msg    []byte
+}
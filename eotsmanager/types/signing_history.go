@@ -0,0 +1,102 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// SigningHistoryVersion is the version of the signing history interchange format
+const SigningHistoryVersion = 1
+
+// SigningHistory is the interchange format of the EOTS signatures produced by
+// an EOTS manager. It is used to carry the signing history of EOTS keys when
+// they are migrated between EOTS managers
+type SigningHistory struct {
+	Version uint32               `json:"version"`
+	Keys    []*KeySigningHistory `json:"keys"`
+}
+
+// KeySigningHistory is the signing history of a single EOTS key
+type KeySigningHistory struct {
+	// EOTSPkHex is the hex of the BIP-340 EOTS public key
+	EOTSPkHex string                 `json:"eots_pk_hex"`
+	Chains    []*ChainSigningHistory `json:"chains"`
+}
+
+// ChainSigningHistory is the signing history of an EOTS key on a single chain
+type ChainSigningHistory struct {
+	ChainID string `json:"chain_id"`
+	// HighWaterMark is the height at or below which the key must not sign
+	// new messages on the chain
+	HighWaterMark uint64 `json:"high_water_mark"`
+	// Records are the signed heights, which can be omitted if only the
+	// high-water mark is carried
+	Records []*SignedHeight `json:"records,omitempty"`
+}
+
+// SignedHeight is an EOTS signature produced at a certain height
+type SignedHeight struct {
+	Height     uint64 `json:"height"`
+	MsgHashHex string `json:"msg_hash_hex"`
+	// SignatureHex is optional
+	SignatureHex string `json:"signature_hex,omitempty"`
+}
+
+// SigningHistoryImportResult summarises the merge of a signing history
+type SigningHistoryImportResult struct {
+	EOTSPkHex     string   `json:"eots_pk_hex"`
+	ChainID       string   `json:"chain_id"`
+	HighWaterMark uint64   `json:"high_water_mark"`
+	NumRecords    int      `json:"num_records"`
+	Conflicts     []uint64 `json:"conflicts,omitempty"`
+}
+
+// Validate checks the signing history is well-formed, and that each key has at most one
+// record at each height on each chain, as the import would keep only one of them
+func (h *SigningHistory) Validate() error {
+	if h.Version != SigningHistoryVersion {
+		return fmt.Errorf("unsupported signing history version %d, expected %d", h.Version, SigningHistoryVersion)
+	}
+
+	type signedHeightKey struct {
+		pk      string
+		chainID string
+		height  uint64
+	}
+	signedHeights := make(map[signedHeightKey]struct{})
+
+	for _, k := range h.Keys {
+		pk, err := hex.DecodeString(k.EOTSPkHex)
+		if err != nil || len(pk) != 32 {
+			return fmt.Errorf("invalid EOTS public key %s", k.EOTSPkHex)
+		}
+
+		for _, c := range k.Chains {
+			if c.ChainID == "" {
+				return fmt.Errorf("empty chain ID for EOTS public key %s", k.EOTSPkHex)
+			}
+
+			for _, r := range c.Records {
+				msgHash, err := hex.DecodeString(r.MsgHashHex)
+				if err != nil || len(msgHash) != 32 {
+					return fmt.Errorf("invalid message hash at height %d on chain %s", r.Height, c.ChainID)
+				}
+				key := signedHeightKey{pk: string(pk), chainID: c.ChainID, height: r.Height}
+				if _, ok := signedHeights[key]; ok {
+					return fmt.Errorf("duplicate records at height %d on chain %s for EOTS public key %s",
+						r.Height, c.ChainID, k.EOTSPkHex)
+				}
+				signedHeights[key] = struct{}{}
+				if r.SignatureHex == "" {
+					continue
+				}
+				sig, err := hex.DecodeString(r.SignatureHex)
+				if err != nil || len(sig) != 32 {
+					return fmt.Errorf("invalid signature at height %d on chain %s", r.Height, c.ChainID)
+				}
+			}
+		}
+	}
+
+	return nil
+}
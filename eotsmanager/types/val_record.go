@@ -1,8 +1,19 @@
 package types
 
-import "github.com/btcsuite/btcd/btcec/v2"
+import (
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+)
 
 type KeyRecord struct {
-	Name    string
+	Name string
+	// PrivKey is only set by the LocalEOTSManager, the private key is
+	// never returned by the RPC server of the EOTS manager
 	PrivKey *btcec.PrivateKey
 }
+
+// KeyInfo is the public information of an EOTS key
+type KeyInfo struct {
+	Name   string
+	EOTSPk *bbntypes.BIP340PubKey
+}
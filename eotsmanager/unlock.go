@@ -0,0 +1,119 @@
+package eotsmanager
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"go.uber.org/zap"
+)
+
+// unlockedKey is a decrypted EOTS private key kept in memory until it is locked or expires
+type unlockedKey struct {
+	privKey *btcec.PrivateKey
+	timer   *time.Timer
+}
+
+// wipe stops the expiry timer and zeroes the private key
+func (k *unlockedKey) wipe() {
+	k.timer.Stop()
+	k.privKey.Zero()
+}
+
+// Unlock decrypts the EOTS private key of the given public key and keeps it in memory for the
+// given duration, during which the key signs without the passphrase. Unlocking an unlocked key
+// resets its expiry. The key is wiped from memory when it expires or is locked. No key is
+// unlocked while the EOTS manager is frozen
+func (lm *LocalEOTSManager) Unlock(fpPk []byte, passphrase string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("the unlock duration should be positive, got %v", ttl)
+	}
+
+	lm.freezeMu.RLock()
+	defer lm.freezeMu.RUnlock()
+	if err := lm.checkFrozen(); err != nil {
+		return err
+	}
+
+	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to get EOTS private key: %w", err)
+	}
+
+	pkHex := hex.EncodeToString(fpPk)
+	k := &unlockedKey{privKey: privKey}
+
+	lm.unlockMu.Lock()
+	defer lm.unlockMu.Unlock()
+
+	if old, ok := lm.unlockedKeys[pkHex]; ok {
+		old.wipe()
+	}
+	k.timer = time.AfterFunc(ttl, func() {
+		lm.expireKey(pkHex, k)
+	})
+	lm.unlockedKeys[pkHex] = k
+
+	lm.logger.Info("unlocked EOTS key", zap.String("pk", pkHex), zap.Duration("ttl", ttl))
+
+	return nil
+}
+
+// Lock wipes the unlocked EOTS private key of the given public key from memory
+// It is a no-op if the key is not unlocked
+func (lm *LocalEOTSManager) Lock(fpPk []byte) error {
+	pkHex := hex.EncodeToString(fpPk)
+
+	lm.unlockMu.Lock()
+	defer lm.unlockMu.Unlock()
+
+	if k, ok := lm.unlockedKeys[pkHex]; ok {
+		k.wipe()
+		delete(lm.unlockedKeys, pkHex)
+		lm.logger.Info("locked EOTS key", zap.String("pk", pkHex))
+	}
+
+	return nil
+}
+
+func (lm *LocalEOTSManager) expireKey(pkHex string, k *unlockedKey) {
+	lm.unlockMu.Lock()
+	defer lm.unlockMu.Unlock()
+
+	// the key may have been locked or unlocked again in the meantime
+	if lm.unlockedKeys[pkHex] != k {
+		return
+	}
+
+	k.wipe()
+	delete(lm.unlockedKeys, pkHex)
+	lm.logger.Info("the unlocked EOTS key expired", zap.String("pk", pkHex))
+}
+
+// lockAll wipes all the unlocked EOTS private keys from memory
+func (lm *LocalEOTSManager) lockAll() {
+	lm.unlockMu.Lock()
+	defer lm.unlockMu.Unlock()
+
+	for pkHex, k := range lm.unlockedKeys {
+		k.wipe()
+		delete(lm.unlockedKeys, pkHex)
+	}
+}
+
+// getSigningKey returns a copy of the unlocked EOTS private key of the given public key,
+// or decrypts it with the passphrase if the key is not unlocked
+func (lm *LocalEOTSManager) getSigningKey(fpPk []byte, passphrase string) (*btcec.PrivateKey, error) {
+	lm.unlockMu.Lock()
+	k, ok := lm.unlockedKeys[hex.EncodeToString(fpPk)]
+	if ok {
+		// the copy stays valid for the caller even if the key is wiped concurrently
+		privKey, _ := btcec.PrivKeyFromBytes(k.privKey.Serialize())
+		lm.unlockMu.Unlock()
+		return privKey, nil
+	}
+	lm.unlockMu.Unlock()
+
+	return lm.getEOTSPrivKey(fpPk, passphrase)
+}
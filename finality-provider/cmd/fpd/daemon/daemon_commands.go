@@ -13,7 +13,6 @@ import (
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	fpcmd "github.com/babylonlabs-io/finality-provider/finality-provider/cmd"
 	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
-	dc "github.com/babylonlabs-io/finality-provider/finality-provider/service/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -37,6 +36,7 @@ func CommandGetDaemonInfo() *cobra.Command {
 		RunE:    runCommandGetDaemonInfo,
 	}
 	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(cmd.Flags())
 	return cmd
 }
 
@@ -46,7 +46,7 @@ func runCommandGetDaemonInfo(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
 	}
 
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
 	if err != nil {
 		return err
 	}
@@ -84,6 +84,7 @@ func CommandCreateFP() *cobra.Command {
 
 	f := cmd.Flags()
 	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(f)
 	f.String(keyNameFlag, "", "The unique name of the finality provider key")
 	f.String(sdkflags.FlagHome, fpcfg.DefaultFpdDir, "The application home directory")
 	f.String(chainIdFlag, "", "The identifier of the consumer chain")
@@ -126,7 +127,7 @@ func runCommandCreateFP(ctx client.Context, cmd *cobra.Command, _ []string) erro
 		return fmt.Errorf("not able to load key name: %w", err)
 	}
 
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
 	if err != nil {
 		return err
 	}
@@ -182,6 +183,89 @@ func runCommandCreateFP(ctx client.Context, cmd *cobra.Command, _ []string) erro
 	return nil
 }
 
+// CommandImportFP returns the import-finality-provider command by connecting to the fpd daemon.
+func CommandImportFP() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:     "import-finality-provider",
+		Aliases: []string{"ifp"},
+		Short:   "Import a finality provider registered on the consumer chain and save it in database.",
+		Long: fmt.Sprintf(`
+		Rebuild the finality provider object of a finality provider that is already registered on the
+		consumer chain, e.g., when moving it to a fresh home directory, and store it in the finality
+		provider database. It needs to have an operating EOTS manager available and running.
+
+		The EOTS key of the public key set by the flag %s must be managed by the EOTS manager, and the
+		address of the chain key must match the address of the registered finality provider. The status
+		and the last voted and processed heights are recovered from the consumer chain`, fpEotsPkFlag),
+		Example: fmt.Sprintf(`fpd import-finality-provider --eots-pk [fp-eots-pk-hex] --daemon-address %s ...`, defaultFpdDaemonAddress),
+		Args:    cobra.NoArgs,
+		RunE:    fpcmd.RunEWithClientCtx(runCommandImportFP),
+	}
+
+	f := cmd.Flags()
+	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(f)
+	f.String(keyNameFlag, "", "The unique name of the finality provider key")
+	f.String(sdkflags.FlagHome, fpcfg.DefaultFpdDir, "The application home directory")
+	f.String(chainIdFlag, "", "The identifier of the consumer chain, the one in the config is used if not provided")
+	f.String(passphraseFlag, "", "The pass phrase used to decrypt the keys")
+	f.String(fpEotsPkFlag, "", "The hex EOTS public key of the registered finality provider")
+
+	return cmd
+}
+
+func runCommandImportFP(ctx client.Context, cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+	daemonAddress, err := flags.GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	eotsPkHex, err := flags.GetString(fpEotsPkFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpEotsPkFlag, err)
+	}
+	if eotsPkHex == "" {
+		return fmt.Errorf("the flag %s is required", fpEotsPkFlag)
+	}
+	if _, err := types.NewBIP340PubKeyFromHex(eotsPkHex); err != nil {
+		return fmt.Errorf("invalid eots public key %s: %w", eotsPkHex, err)
+	}
+
+	keyName, err := loadKeyName(ctx.HomeDir, cmd)
+	if err != nil {
+		return fmt.Errorf("not able to load key name: %w", err)
+	}
+
+	chainId, err := flags.GetString(chainIdFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", chainIdFlag, err)
+	}
+
+	passphrase, err := flags.GetString(passphraseFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", passphraseFlag, err)
+	}
+
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	res, err := client.ImportFinalityProvider(context.Background(), eotsPkHex, keyName, chainId, passphrase)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(res.FinalityProvider)
+	return nil
+}
+
 // CommandUnjailFP returns the unjail-finality-provider command by connecting to the fpd daemon.
 func CommandUnjailFP() *cobra.Command {
 	var cmd = &cobra.Command{
@@ -195,6 +279,7 @@ func CommandUnjailFP() *cobra.Command {
 
 	f := cmd.Flags()
 	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(f)
 
 	return cmd
 }
@@ -206,7 +291,7 @@ func runCommandUnjailFP(_ client.Context, cmd *cobra.Command, args []string) err
 		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
 	}
 
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
 	if err != nil {
 		return err
 	}
@@ -262,6 +347,7 @@ func CommandLsFP() *cobra.Command {
 		RunE:    runCommandLsFP,
 	}
 	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(cmd.Flags())
 	return cmd
 }
 
@@ -271,7 +357,7 @@ func runCommandLsFP(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
 	}
 
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
 	if err != nil {
 		return err
 	}
@@ -290,6 +376,242 @@ func runCommandLsFP(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// CommandLsRunningFP returns the list-running-finality-providers command by connecting to the fpd daemon.
+func CommandLsRunningFP() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:     "list-running-finality-providers",
+		Aliases: []string{"lsr"},
+		Short:   "List finality providers whose instances are running in the daemon.",
+		Example: fmt.Sprintf(`fpd list-running-finality-providers --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.NoArgs,
+		RunE:    runCommandLsRunningFP,
+	}
+	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(cmd.Flags())
+	return cmd
+}
+
+func runCommandLsRunningFP(cmd *cobra.Command, args []string) error {
+	daemonAddress, err := cmd.Flags().GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	resp, err := client.QueryRunningFinalityProviderList(context.Background())
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+
+	return nil
+}
+
+// CommandStartFP returns the start-finality-provider command by connecting to the fpd daemon.
+func CommandStartFP() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:     "start-finality-provider [fp-eots-pk-hex]",
+		Aliases: []string{"startfp"},
+		Short:   "Start the instance of a finality provider in the running daemon.",
+		Example: fmt.Sprintf(`fpd start-finality-provider [fp-eots-pk-hex] --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runCommandStartFP,
+	}
+	f := cmd.Flags()
+	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(f)
+	f.String(passphraseFlag, "", "The pass phrase used to decrypt the private key")
+	return cmd
+}
+
+func runCommandStartFP(cmd *cobra.Command, args []string) error {
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(args[0])
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	daemonAddress, err := flags.GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	passphrase, err := flags.GetString(passphraseFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", passphraseFlag, err)
+	}
+
+	client, cleanUp, err := newFpdClient(flags, daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	if err := client.StartFinalityProvider(context.Background(), fpPk.MarshalHex(), passphrase); err != nil {
+		return err
+	}
+
+	fmt.Printf("Finality provider %s is started\n", fpPk.MarshalHex())
+
+	return nil
+}
+
+// CommandStopFP returns the stop-finality-provider command by connecting to the fpd daemon.
+func CommandStopFP() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:     "stop-finality-provider [fp-eots-pk-hex]",
+		Aliases: []string{"stopfp"},
+		Short:   "Stop the running instance of a finality provider without stopping the daemon.",
+		Example: fmt.Sprintf(`fpd stop-finality-provider [fp-eots-pk-hex] --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runCommandStopFP,
+	}
+	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(cmd.Flags())
+	return cmd
+}
+
+func runCommandStopFP(cmd *cobra.Command, args []string) error {
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(args[0])
+	if err != nil {
+		return err
+	}
+
+	daemonAddress, err := cmd.Flags().GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	if err := client.StopFinalityProvider(context.Background(), fpPk.MarshalHex()); err != nil {
+		return err
+	}
+
+	fmt.Printf("Finality provider %s is stopped\n", fpPk.MarshalHex())
+
+	return nil
+}
+
+// CommandPauseFP returns the pause-finality-provider command by connecting to the fpd daemon.
+func CommandPauseFP() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:     "pause-finality-provider [fp-eots-pk-hex]",
+		Aliases: []string{"pausefp"},
+		Short:   "Pause a finality provider, which keeps committing public randomness but submits no finality signatures.",
+		Example: fmt.Sprintf(`fpd pause-finality-provider [fp-eots-pk-hex] --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runCommandPauseFP,
+	}
+	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(cmd.Flags())
+	return cmd
+}
+
+func runCommandPauseFP(cmd *cobra.Command, args []string) error {
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(args[0])
+	if err != nil {
+		return err
+	}
+
+	daemonAddress, err := cmd.Flags().GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	if err := client.PauseFinalityProvider(context.Background(), fpPk.MarshalHex()); err != nil {
+		return err
+	}
+
+	fmt.Printf("Finality provider %s is paused\n", fpPk.MarshalHex())
+
+	return nil
+}
+
+// CommandResumeFP returns the resume-finality-provider command by connecting to the fpd daemon.
+func CommandResumeFP() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:     "resume-finality-provider [fp-eots-pk-hex]",
+		Aliases: []string{"resumefp"},
+		Short:   "Resume a paused finality provider, and start its instance if it is not running.",
+		Example: fmt.Sprintf(`fpd resume-finality-provider [fp-eots-pk-hex] --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runCommandResumeFP,
+	}
+	f := cmd.Flags()
+	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(f)
+	f.String(passphraseFlag, "", "The pass phrase used to decrypt the private key")
+	return cmd
+}
+
+func runCommandResumeFP(cmd *cobra.Command, args []string) error {
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(args[0])
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	daemonAddress, err := flags.GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	passphrase, err := flags.GetString(passphraseFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", passphraseFlag, err)
+	}
+
+	client, cleanUp, err := newFpdClient(flags, daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	if err := client.ResumeFinalityProvider(context.Background(), fpPk.MarshalHex(), passphrase); err != nil {
+		return err
+	}
+
+	fmt.Printf("Finality provider %s is resumed\n", fpPk.MarshalHex())
+
+	return nil
+}
+
 // CommandInfoFP returns the finality-provider-info command by connecting to the fpd daemon.
 func CommandInfoFP() *cobra.Command {
 	var cmd = &cobra.Command{
@@ -301,6 +623,7 @@ func CommandInfoFP() *cobra.Command {
 		RunE:    runCommandInfoFP,
 	}
 	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(cmd.Flags())
 	return cmd
 }
 
@@ -315,7 +638,7 @@ func runCommandInfoFP(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
 	}
 
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
 	if err != nil {
 		return err
 	}
@@ -334,6 +657,118 @@ func runCommandInfoFP(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// CommandVotes returns the votes command by connecting to the fpd daemon.
+func CommandVotes() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "votes [fp-eots-pk-hex]",
+		Short: "List the votes of a finality provider recorded in the vote journal.",
+		Example: fmt.Sprintf(`fpd votes [fp-eots-pk-hex] --start-height 100 --limit 10 --daemon-address %s`,
+			defaultFpdDaemonAddress),
+		Args: cobra.ExactArgs(1),
+		RunE: runCommandVotes,
+	}
+	f := cmd.Flags()
+	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(f)
+	f.Uint64(startHeightFlag, 0, "The height from which the votes are listed; 0 to start from the lowest height, or the highest height with --reverse")
+	f.Uint32(limitFlag, 0, "The maximum number of the votes listed; 0 to use the default limit of the daemon")
+	f.Bool(reverseFlag, false, "List the votes in the descending order of height")
+	return cmd
+}
+
+func runCommandVotes(cmd *cobra.Command, args []string) error {
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(args[0])
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	daemonAddress, err := flags.GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	startHeight, err := flags.GetUint64(startHeightFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", startHeightFlag, err)
+	}
+
+	limit, err := flags.GetUint32(limitFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", limitFlag, err)
+	}
+
+	reverse, err := flags.GetBool(reverseFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", reverseFlag, err)
+	}
+
+	client, cleanUp, err := newFpdClient(flags, daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	resp, err := client.QueryVotes(context.Background(), fpPk.MarshalHex(), startHeight, limit, reverse)
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+
+	return nil
+}
+
+// CommandRecoverState returns the recover-state command by connecting to the fpd daemon.
+func CommandRecoverState() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "recover-state [fp-eots-pk-hex]",
+		Short: "Recover the last voted and processed heights of a finality provider from its votes on the consumer chain.",
+		Long: `Recover the last voted and processed heights of a finality provider from its votes on the consumer chain,
+e.g., after the loss of the local db. The heights are only raised, never lowered.`,
+		Example: fmt.Sprintf(`fpd recover-state [fp-eots-pk-hex] --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runCommandRecoverState,
+	}
+	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(cmd.Flags())
+	return cmd
+}
+
+func runCommandRecoverState(cmd *cobra.Command, args []string) error {
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(args[0])
+	if err != nil {
+		return err
+	}
+
+	daemonAddress, err := cmd.Flags().GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	res, err := client.RecoverFinalityProviderState(context.Background(), fpPk.MarshalHex())
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(res)
+
+	return nil
+}
+
 // CommandRegisterFP returns the register-finality-provider command by connecting to the fpd daemon.
 func CommandRegisterFP() *cobra.Command {
 	var cmd = &cobra.Command{
@@ -346,6 +781,7 @@ func CommandRegisterFP() *cobra.Command {
 	}
 	f := cmd.Flags()
 	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(f)
 	f.String(passphraseFlag, "", "The pass phrase used to encrypt the keys")
 	return cmd
 }
@@ -362,7 +798,7 @@ func runCommandRegisterFP(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
 	}
 
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
 	if err != nil {
 		return err
 	}
@@ -397,6 +833,7 @@ func CommandAddFinalitySig() *cobra.Command {
 		RunE:    runCommandAddFinalitySig,
 	}
 	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(cmd.Flags())
 	cmd.Flags().String(appHashFlag, defaultAppHashStr, "The last commit hash of the chain block")
 	return cmd
 }
@@ -422,7 +859,7 @@ func runCommandAddFinalitySig(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read flag %s: %w", appHashFlag, err)
 	}
 
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
 	if err != nil {
 		return err
 	}
@@ -461,6 +898,7 @@ func CommandEditFinalityDescription() *cobra.Command {
 		RunE:    runCommandEditFinalityDescription,
 	}
 	cmd.Flags().String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(cmd.Flags())
 	cmd.Flags().String(monikerFlag, "", "The finality provider's (optional) moniker")
 	cmd.Flags().String(websiteFlag, "", "The finality provider's (optional) website")
 	cmd.Flags().String(securityContactFlag, "", "The finality provider's (optional) security contact email")
@@ -483,7 +921,7 @@ func runCommandEditFinalityDescription(cmd *cobra.Command, args []string) error
 		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
 	}
 
-	grpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	grpcClient, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
 	if err != nil {
 		return err
 	}
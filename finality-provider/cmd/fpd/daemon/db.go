@@ -0,0 +1,88 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	fpcmd "github.com/babylonlabs-io/finality-provider/finality-provider/cmd"
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+// DBCompactResponse is the output of the db compact command
+type DBCompactResponse struct {
+	DBFile     string `json:"db_file"`
+	SizeBefore int64  `json:"size_before"`
+	SizeAfter  int64  `json:"size_after"`
+}
+
+// CommandDB returns the database commands of the fpd daemon.
+func CommandDB() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "db",
+		Short:                      "database subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CommandCompactDB(),
+	)
+
+	return cmd
+}
+
+// CommandCompactDB returns the db compact command.
+func CommandCompactDB() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "compact",
+		Short: "Compact the database of the finality-provider app.",
+		Long: `Compact the bolt database of the finality-provider app to release the disk space of the deleted data,
+e.g., the pruned public randomness proofs. The compaction requires additional disk space for the compacted copy
+of the database. Note that fpd must be stopped beforehand as the database cannot be opened by two processes.`,
+		Example: `fpd db compact --home /home/user/.fpd`,
+		Args:    cobra.NoArgs,
+		RunE:    fpcmd.RunEWithClientCtx(runCommandCompactDB),
+	}
+	return cmd
+}
+
+func runCommandCompactDB(ctx client.Context, _ *cobra.Command, _ []string) error {
+	homePath, err := filepath.Abs(ctx.HomeDir)
+	if err != nil {
+		return err
+	}
+	homePath = util.CleanAndExpandPath(homePath)
+
+	cfg, err := fpcfg.LoadConfig(homePath)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	dbFile := cfg.DatabaseConfig.DBFilePath()
+	before, err := os.Stat(dbFile)
+	if err != nil {
+		return fmt.Errorf("failed to find the database file: %w", err)
+	}
+
+	if err := cfg.DatabaseConfig.Compact(); err != nil {
+		return err
+	}
+
+	after, err := os.Stat(dbFile)
+	if err != nil {
+		return fmt.Errorf("failed to find the compacted database file: %w", err)
+	}
+
+	printRespJSON(DBCompactResponse{
+		DBFile:     dbFile,
+		SizeBefore: before.Size(),
+		SizeAfter:  after.Size(),
+	})
+
+	return nil
+}
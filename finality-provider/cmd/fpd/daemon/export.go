@@ -15,7 +15,6 @@ import (
 
 	fpcmd "github.com/babylonlabs-io/finality-provider/finality-provider/cmd"
 	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
-	dc "github.com/babylonlabs-io/finality-provider/finality-provider/service/client"
 )
 
 // FinalityProviderSigned wraps the finality provider by adding the
@@ -40,6 +39,7 @@ func CommandExportFP() *cobra.Command {
 
 	f := cmd.Flags()
 	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(f)
 	f.Bool(signedFlag, false,
 		`Specify if the exported finality provider information should be signed,
 			if true, it will sign using the flag key-name, if not set it will load from the
@@ -60,7 +60,7 @@ func runCommandExportFP(ctx client.Context, cmd *cobra.Command, args []string) e
 		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
 	}
 
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := newFpdClient(cmd.Flags(), daemonAddress)
 	if err != nil {
 		return fmt.Errorf("failled to connect to daemon addr %s: %w", daemonAddress, err)
 	}
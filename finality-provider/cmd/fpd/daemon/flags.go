@@ -12,6 +12,17 @@ const (
 	chainIdFlag          = "chain-id"
 	signedFlag           = "signed"
 
+	// flags for paginating the votes
+	startHeightFlag = "start-height"
+	limitFlag       = "limit"
+	reverseFlag     = "reverse"
+
+	// flags for authenticating to the RPC server
+	tlsCACertFlag = "tls-ca-cert"
+	tlsCertFlag   = "tls-cert"
+	tlsKeyFlag    = "tls-key"
+	tokenFileFlag = "token-file"
+
 	// flags for description
 	monikerFlag         = "moniker"
 	identityFlag        = "identity"
@@ -0,0 +1,82 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// CommandPubRand returns the public randomness commands of the finality providers.
+func CommandPubRand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "pubrand",
+		Short:                      "public randomness subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CommandRepairPubRand(),
+	)
+
+	return cmd
+}
+
+// CommandRepairPubRand returns the pubrand repair command by connecting to the fpd daemon.
+func CommandRepairPubRand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "repair [fp-eots-pk-hex]",
+		Short: "Regenerate the missing inclusion proofs of the public randomness committed by a finality provider.",
+		Long: `Regenerate the inclusion proofs of the public randomness committed on the consumer chain by a finality
+provider that are missing from the local db, e.g., after the loss of the db. The randomness is regenerated by
+the EOTS manager and nothing is saved unless the regenerated commitments match the ones on the consumer chain.`,
+		Example: fmt.Sprintf(`fpd pubrand repair [fp-eots-pk-hex] --daemon-address %s`, defaultFpdDaemonAddress),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runCommandRepairPubRand,
+	}
+	f := cmd.Flags()
+	f.String(fpdDaemonAddressFlag, defaultFpdDaemonAddress, "The RPC server address of fpd")
+	addRPCClientFlags(f)
+	f.String(passphraseFlag, "", "The pass phrase used to decrypt the EOTS key if the finality provider is not running")
+	return cmd
+}
+
+func runCommandRepairPubRand(cmd *cobra.Command, args []string) error {
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(args[0])
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	daemonAddress, err := flags.GetString(fpdDaemonAddressFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", fpdDaemonAddressFlag, err)
+	}
+
+	passphrase, err := flags.GetString(passphraseFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read flag %s: %w", passphraseFlag, err)
+	}
+
+	client, cleanUp, err := newFpdClient(flags, daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cleanUp(); err != nil {
+			fmt.Printf("Failed to clean up grpc client: %v\n", err)
+		}
+	}()
+
+	res, err := client.RepairPubRandProofs(context.Background(), fpPk.MarshalHex(), passphrase)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(res)
+
+	return nil
+}
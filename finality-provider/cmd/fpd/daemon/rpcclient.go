@@ -0,0 +1,41 @@
+package daemon
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	dc "github.com/babylonlabs-io/finality-provider/finality-provider/service/client"
+	"github.com/babylonlabs-io/finality-provider/rpcauth"
+)
+
+// addRPCClientFlags adds the flags of authenticating to the RPC server of fpd
+func addRPCClientFlags(f *pflag.FlagSet) {
+	f.String(tlsCACertFlag, "", "The path of the CA certificates verifying the certificate of fpd; empty to connect without TLS")
+	f.String(tlsCertFlag, "", "The path of the TLS client certificate")
+	f.String(tlsKeyFlag, "", "The path of the TLS client private key")
+	f.String(tokenFileFlag, "", "The path of the file of the bearer token sent to fpd")
+}
+
+// newFpdClient connects to the fpd daemon at the given address, authenticating by
+// the flags added by addRPCClientFlags
+func newFpdClient(f *pflag.FlagSet, daemonAddress string) (*dc.FinalityProviderServiceGRpcClient, func() error, error) {
+	authCfg := &rpcauth.ClientConfig{}
+	for flag, v := range map[string]*string{
+		tlsCACertFlag: &authCfg.TLSCACertPath,
+		tlsCertFlag:   &authCfg.TLSCertPath,
+		tlsKeyFlag:    &authCfg.TLSKeyPath,
+		tokenFileFlag: &authCfg.TokenFile,
+	} {
+		value, err := f.GetString(flag)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read flag %s: %w", flag, err)
+		}
+		*v = value
+	}
+	if err := authCfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return dc.NewFinalityProviderServiceGRpcClient(daemonAddress, authCfg)
+}
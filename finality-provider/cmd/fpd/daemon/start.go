@@ -3,7 +3,6 @@ package daemon
 import (
 	"errors"
 	"fmt"
-	"net"
 	"path/filepath"
 
 	"github.com/babylonlabs-io/babylon/types"
@@ -65,8 +64,7 @@ func runStartCmd(ctx client.Context, cmd *cobra.Command, args []string) error {
 	}
 
 	if rpcListener != "" {
-		_, err := net.ResolveTCPAddr("tcp", rpcListener)
-		if err != nil {
+		if err := util.ValidateListenAddr(rpcListener); err != nil {
 			return fmt.Errorf("invalid RPC listener address %s, %w", rpcListener, err)
 		}
 		cfg.RpcListener = rpcListener
@@ -34,7 +34,10 @@ func main() {
 		daemon.CommandGetDaemonInfo(), daemon.CommandCreateFP(), daemon.CommandLsFP(),
 		daemon.CommandInfoFP(), daemon.CommandRegisterFP(), daemon.CommandAddFinalitySig(),
 		daemon.CommandExportFP(), daemon.CommandTxs(), daemon.CommandUnjailFP(),
-		daemon.CommandEditFinalityDescription(),
+		daemon.CommandEditFinalityDescription(), daemon.CommandLsRunningFP(),
+		daemon.CommandStartFP(), daemon.CommandStopFP(), daemon.CommandPauseFP(),
+		daemon.CommandResumeFP(), daemon.CommandVotes(), daemon.CommandRecoverState(),
+		daemon.CommandImportFP(), daemon.CommandPubRand(), daemon.CommandDB(),
 	)
 
 	if err := cmd.Execute(); err != nil {
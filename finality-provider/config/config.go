@@ -2,7 +2,6 @@ package config
 
 import (
 	"fmt"
-	"net"
 	"path/filepath"
 	"strconv"
 	"time"
@@ -12,8 +11,11 @@ import (
 	"github.com/jessevdk/go-flags"
 	"go.uber.org/zap/zapcore"
 
+	eotsclient "github.com/babylonlabs-io/finality-provider/eotsmanager/client"
 	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/gateway"
 	"github.com/babylonlabs-io/finality-provider/metrics"
+	"github.com/babylonlabs-io/finality-provider/rpcauth"
 	"github.com/babylonlabs-io/finality-provider/util"
 )
 
@@ -78,10 +80,28 @@ type Config struct {
 
 	DatabaseConfig *DBConfig `group:"dbconfig" namespace:"dbconfig"`
 
+	EOTSManagerConfig *EOTSManagerConfig `group:"eotsmanager" namespace:"eotsmanager"`
+
+	EOTSManagerAuth *rpcauth.ClientConfig `group:"eotsmanagerauth" namespace:"eotsmanagerauth"`
+
+	EOTSManagerClient *eotsclient.Config `group:"eotsmanagerclient" namespace:"eotsmanagerclient"`
+
 	BabylonConfig *BBNConfig `group:"babylon" namespace:"babylon"`
 
 	RpcListener string `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
 
+	RPCAuth *rpcauth.ServerConfig `group:"rpcauth" namespace:"rpcauth"`
+
+	RPCSocket *util.UnixSocketConfig `group:"rpcsocket" namespace:"rpcsocket"`
+
+	Gateway *gateway.Config `group:"gateway" namespace:"gateway"`
+
+	VoteJournal *VoteJournalConfig `group:"votejournal" namespace:"votejournal"`
+
+	StateRecovery *StateRecoveryConfig `group:"staterecovery" namespace:"staterecovery"`
+
+	PubRandPruning *PubRandPruningConfig `group:"pubrandpruning" namespace:"pubrandpruning"`
+
 	Metrics *metrics.Config `group:"metrics" namespace:"metrics"`
 }
 
@@ -94,6 +114,9 @@ func DefaultConfigWithHome(homePath string) Config {
 		ChainName:                defaultChainName,
 		LogLevel:                 defaultLogLevel.String(),
 		DatabaseConfig:           DefaultDBConfigWithHomePath(homePath),
+		EOTSManagerConfig:        DefaultEOTSManagerConfigWithHomePath(homePath),
+		EOTSManagerAuth:          rpcauth.DefaultClientConfig(),
+		EOTSManagerClient:        eotsclient.DefaultConfig(),
 		BabylonConfig:            &bbnCfg,
 		PollerConfig:             &pollerCfg,
 		NumPubRand:               defaultNumPubRand,
@@ -110,6 +133,12 @@ func DefaultConfigWithHome(homePath string) Config {
 		BTCNetParams:             defaultBTCNetParams,
 		EOTSManagerAddress:       defaultEOTSManagerAddress,
 		RpcListener:              DefaultRpcListener,
+		RPCAuth:                  rpcauth.DefaultServerConfig(),
+		RPCSocket:                util.DefaultUnixSocketConfig(),
+		Gateway:                  gateway.DefaultConfig(),
+		VoteJournal:              DefaultVoteJournalConfig(),
+		StateRecovery:            DefaultStateRecoveryConfig(),
+		PubRandPruning:           DefaultPubRandPruningConfig(),
 		Metrics:                  metrics.DefaultFpConfig(),
 		SyncFpStatusInterval:     defaultSyncFpStatusInterval,
 	}
@@ -178,8 +207,18 @@ func LoadConfig(homePath string) (*Config, error) {
 // illegal values or a combination of values are set. All file system paths are
 // normalized. The cleaned up config is returned on success.
 func (cfg *Config) Validate() error {
+	// the EOTS manager runs in the fpd process if the address is empty
 	if cfg.EOTSManagerAddress == "" {
-		return fmt.Errorf("EOTS manager address not specified")
+		if cfg.EOTSManagerConfig == nil {
+			return fmt.Errorf("empty EOTS manager config while the EOTS manager address is not specified")
+		}
+		if err := cfg.EOTSManagerConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid EOTS manager config: %w", err)
+		}
+	} else if util.IsUnixSocketAddr(cfg.EOTSManagerAddress) {
+		if err := util.ValidateListenAddr(cfg.EOTSManagerAddress); err != nil {
+			return fmt.Errorf("invalid EOTS manager address %s, %w", cfg.EOTSManagerAddress, err)
+		}
 	}
 	// Multiple networks can't be selected simultaneously.  Count number of
 	// network flags passed; assign active network params
@@ -190,11 +229,57 @@ func (cfg *Config) Validate() error {
 	}
 	cfg.BTCNetParams = btcNetConfig
 
-	_, err = net.ResolveTCPAddr("tcp", cfg.RpcListener)
-	if err != nil {
+	if err := util.ValidateListenAddr(cfg.RpcListener); err != nil {
 		return fmt.Errorf("invalid RPC listener address %s, %w", cfg.RpcListener, err)
 	}
 
+	// the authentication is optional for the config files written before it was introduced
+	if cfg.RPCAuth != nil {
+		if err := cfg.RPCAuth.Validate(); err != nil {
+			return fmt.Errorf("invalid RPC authentication config: %w", err)
+		}
+	}
+	if cfg.EOTSManagerAuth != nil {
+		if err := cfg.EOTSManagerAuth.Validate(); err != nil {
+			return fmt.Errorf("invalid EOTS manager authentication config: %w", err)
+		}
+	}
+	if cfg.EOTSManagerClient != nil {
+		if err := cfg.EOTSManagerClient.Validate(); err != nil {
+			return fmt.Errorf("invalid EOTS manager client config: %w", err)
+		}
+	}
+	// the unix socket config is optional for the config files written before it was introduced
+	if cfg.RPCSocket != nil {
+		if err := cfg.RPCSocket.Validate(); err != nil {
+			return fmt.Errorf("invalid RPC unix socket config: %w", err)
+		}
+	}
+	// the gateway config is optional for the config files written before it was introduced
+	if cfg.Gateway != nil {
+		if err := cfg.Gateway.Validate(); err != nil {
+			return fmt.Errorf("invalid REST gateway config: %w", err)
+		}
+	}
+	// the vote journal config is optional for the config files written before it was introduced
+	if cfg.VoteJournal != nil {
+		if err := cfg.VoteJournal.Validate(); err != nil {
+			return fmt.Errorf("invalid vote journal config: %w", err)
+		}
+	}
+	// the state recovery config is optional for the config files written before it was introduced
+	if cfg.StateRecovery != nil {
+		if err := cfg.StateRecovery.Validate(); err != nil {
+			return fmt.Errorf("invalid state recovery config: %w", err)
+		}
+	}
+	// the public randomness pruning config is optional for the config files written before it was introduced
+	if cfg.PubRandPruning != nil {
+		if err := cfg.PubRandPruning.Validate(); err != nil {
+			return fmt.Errorf("invalid public randomness pruning config: %w", err)
+		}
+	}
+
 	if cfg.Metrics == nil {
 		return fmt.Errorf("empty metrics config")
 	}
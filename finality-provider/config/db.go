@@ -1,6 +1,9 @@
 package config
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/lightningnetwork/lnd/kvdb"
@@ -70,3 +73,29 @@ func (db *DBConfig) DBConfigToBoltBackendConfig() *kvdb.BoltBackendConfig {
 func (db *DBConfig) GetDbBackend() (kvdb.Backend, error) {
 	return kvdb.GetBoltBackend(db.DBConfigToBoltBackendConfig())
 }
+
+// DBFilePath returns the path of the database file
+func (db *DBConfig) DBFilePath() string {
+	return filepath.Join(db.DBPath, db.DBFileName)
+}
+
+// Compact compacts the database file regardless of AutoCompact and AutoCompactMinAge,
+// which requires additional disk space for the compacted copy of the database. The
+// database must not be opened by another process, e.g., a running fpd
+func (db *DBConfig) Compact() error {
+	if _, err := os.Stat(db.DBFilePath()); err != nil {
+		return fmt.Errorf("failed to find the database file: %w", err)
+	}
+
+	boltCfg := db.DBConfigToBoltBackendConfig()
+	boltCfg.AutoCompact = true
+	boltCfg.AutoCompactMinAge = 0
+
+	// the bolt backend compacts the database file before opening it
+	backend, err := kvdb.GetBoltBackend(boltCfg)
+	if err != nil {
+		return fmt.Errorf("failed to compact the database: %w", err)
+	}
+
+	return backend.Close()
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+
+	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+)
+
+const (
+	defaultEOTSDirname        = "eots"
+	defaultEOTSKeyringBackend = keyring.BackendTest
+)
+
+// EOTSManagerConfig is the config of the EOTS manager running in the fpd process,
+// which is only used if EOTSManagerAddress is empty
+type EOTSManagerConfig struct {
+	KeyDirectory   string            `long:"key-dir" description:"directory to store EOTS keys in"`
+	KeyringBackend string            `long:"keyring-type" description:"type of keyring to use for EOTS keys"`
+	DatabaseConfig *eotscfg.DBConfig `group:"eotsdbconfig" namespace:"eotsdbconfig"`
+}
+
+func DefaultEOTSManagerConfigWithHomePath(homePath string) *EOTSManagerConfig {
+	eotsHome := filepath.Join(homePath, defaultEOTSDirname)
+	return &EOTSManagerConfig{
+		KeyDirectory:   eotsHome,
+		KeyringBackend: defaultEOTSKeyringBackend,
+		DatabaseConfig: eotscfg.DefaultDBConfigWithHomePath(eotsHome),
+	}
+}
+
+func (cfg *EOTSManagerConfig) Validate() error {
+	if cfg.KeyDirectory == "" {
+		return fmt.Errorf("the EOTS key directory should not be empty")
+	}
+
+	if cfg.KeyringBackend == "" {
+		return fmt.Errorf("the EOTS keyring backend should not be empty")
+	}
+
+	if cfg.DatabaseConfig == nil {
+		return fmt.Errorf("empty EOTS database config")
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ var (
 	defaultBufferSize        = uint32(1000)
 	defaultPollingInterval   = 20 * time.Second
 	defaultStaticStartHeight = uint64(1)
+	defaultMaxLag            = uint64(20)
 )
 
 type ChainPollerConfig struct {
@@ -13,6 +14,8 @@ type ChainPollerConfig struct {
 	PollInterval                   time.Duration `long:"pollinterval" description:"The interval between each polling of Babylon blocks"`
 	StaticChainScanningStartHeight uint64        `long:"staticchainscanningstartheight" description:"The static height from which we start polling the chain"`
 	AutoChainScanningMode          bool          `long:"autochainscanningmode" description:"Automatically discover the height from which to start polling the chain"`
+	MaxLag                         uint64        `long:"maxlag" description:"The maximum number of blocks the finality provider can lag behind the chain tip before fpd reports not ready; 0 to not check the lag"`
+	Shared                         bool          `long:"shared" description:"Whether the finality providers share one poller of the chain rather than each polling the chain on its own"`
 }
 
 func DefaultChainPollerConfig() ChainPollerConfig {
@@ -21,5 +24,6 @@ func DefaultChainPollerConfig() ChainPollerConfig {
 		PollInterval:                   defaultPollingInterval,
 		StaticChainScanningStartHeight: defaultStaticStartHeight,
 		AutoChainScanningMode:          true,
+		MaxLag:                         defaultMaxLag,
 	}
 }
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultPubRandPruningSafetyMargin = 1000
+	defaultPubRandPruneInterval       = time.Hour
+)
+
+// PubRandPruningConfig is the config of the pruning of the inclusion proofs of the public
+// randomness committed by the finality providers, which are never needed for finalized blocks,
+// and of the signed blocks pruned along with the proofs
+type PubRandPruningConfig struct {
+	SafetyMargin  uint64        `long:"safetymargin" description:"The number of the heights below the last finalized height whose public randomness proofs are kept"`
+	PruneInterval time.Duration `long:"pruneinterval" description:"The interval between each pruning of the public randomness proofs; 0 to keep all the proofs"`
+}
+
+func DefaultPubRandPruningConfig() *PubRandPruningConfig {
+	return &PubRandPruningConfig{
+		SafetyMargin:  defaultPubRandPruningSafetyMargin,
+		PruneInterval: defaultPubRandPruneInterval,
+	}
+}
+
+func (cfg *PubRandPruningConfig) Validate() error {
+	if cfg.PruneInterval < 0 {
+		return fmt.Errorf("the prune interval should not be negative")
+	}
+
+	return nil
+}
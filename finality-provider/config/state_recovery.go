@@ -0,0 +1,29 @@
+package config
+
+import (
+	"fmt"
+)
+
+const (
+	defaultStateRecoveryScanWindow = 1000
+)
+
+// StateRecoveryConfig is the config of the recovery of the last voted height of the finality
+// providers from the votes on the consumer chain, which is checked before they start voting
+type StateRecoveryConfig struct {
+	ScanWindow uint64 `long:"scanwindow" description:"The maximum number of the latest unfinalized blocks to scan for the votes of a finality provider"`
+}
+
+func DefaultStateRecoveryConfig() *StateRecoveryConfig {
+	return &StateRecoveryConfig{
+		ScanWindow: defaultStateRecoveryScanWindow,
+	}
+}
+
+func (cfg *StateRecoveryConfig) Validate() error {
+	if cfg.ScanWindow == 0 {
+		return fmt.Errorf("the scan window should be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	defaultVoteJournalRetention     = 30 * 24 * time.Hour
+	defaultVoteJournalPruneInterval = time.Hour
+)
+
+// VoteJournalConfig is the config of the vote journal, which records the finality
+// signatures submitted by the finality providers
+type VoteJournalConfig struct {
+	Retention     time.Duration `long:"retention" description:"The duration for which the votes are kept in the vote journal; 0 to keep all the votes"`
+	PruneInterval time.Duration `long:"pruneinterval" description:"The interval between each pruning of the votes older than the retention"`
+}
+
+func DefaultVoteJournalConfig() *VoteJournalConfig {
+	return &VoteJournalConfig{
+		Retention:     defaultVoteJournalRetention,
+		PruneInterval: defaultVoteJournalPruneInterval,
+	}
+}
+
+func (cfg *VoteJournalConfig) Validate() error {
+	if cfg.Retention < 0 {
+		return fmt.Errorf("the retention should not be negative")
+	}
+	if cfg.Retention > 0 && cfg.PruneInterval <= 0 {
+		return fmt.Errorf("the prune interval should be positive if the retention is set")
+	}
+
+	return nil
+}
@@ -19,15 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	FinalityProviders_GetInfo_FullMethodName                   = "/proto.FinalityProviders/GetInfo"
-	FinalityProviders_CreateFinalityProvider_FullMethodName    = "/proto.FinalityProviders/CreateFinalityProvider"
-	FinalityProviders_RegisterFinalityProvider_FullMethodName  = "/proto.FinalityProviders/RegisterFinalityProvider"
-	FinalityProviders_AddFinalitySignature_FullMethodName      = "/proto.FinalityProviders/AddFinalitySignature"
-	FinalityProviders_UnjailFinalityProvider_FullMethodName    = "/proto.FinalityProviders/UnjailFinalityProvider"
-	FinalityProviders_QueryFinalityProvider_FullMethodName     = "/proto.FinalityProviders/QueryFinalityProvider"
-	FinalityProviders_QueryFinalityProviderList_FullMethodName = "/proto.FinalityProviders/QueryFinalityProviderList"
-	FinalityProviders_SignMessageFromChainKey_FullMethodName   = "/proto.FinalityProviders/SignMessageFromChainKey"
-	FinalityProviders_EditFinalityProvider_FullMethodName      = "/proto.FinalityProviders/EditFinalityProvider"
+	FinalityProviders_GetInfo_FullMethodName                          = "/proto.FinalityProviders/GetInfo"
+	FinalityProviders_CreateFinalityProvider_FullMethodName           = "/proto.FinalityProviders/CreateFinalityProvider"
+	FinalityProviders_RegisterFinalityProvider_FullMethodName         = "/proto.FinalityProviders/RegisterFinalityProvider"
+	FinalityProviders_AddFinalitySignature_FullMethodName             = "/proto.FinalityProviders/AddFinalitySignature"
+	FinalityProviders_UnjailFinalityProvider_FullMethodName           = "/proto.FinalityProviders/UnjailFinalityProvider"
+	FinalityProviders_QueryFinalityProvider_FullMethodName            = "/proto.FinalityProviders/QueryFinalityProvider"
+	FinalityProviders_QueryFinalityProviderList_FullMethodName        = "/proto.FinalityProviders/QueryFinalityProviderList"
+	FinalityProviders_SignMessageFromChainKey_FullMethodName          = "/proto.FinalityProviders/SignMessageFromChainKey"
+	FinalityProviders_EditFinalityProvider_FullMethodName             = "/proto.FinalityProviders/EditFinalityProvider"
+	FinalityProviders_StartFinalityProvider_FullMethodName            = "/proto.FinalityProviders/StartFinalityProvider"
+	FinalityProviders_StopFinalityProvider_FullMethodName             = "/proto.FinalityProviders/StopFinalityProvider"
+	FinalityProviders_QueryRunningFinalityProviderList_FullMethodName = "/proto.FinalityProviders/QueryRunningFinalityProviderList"
+	FinalityProviders_PauseFinalityProvider_FullMethodName            = "/proto.FinalityProviders/PauseFinalityProvider"
+	FinalityProviders_ResumeFinalityProvider_FullMethodName           = "/proto.FinalityProviders/ResumeFinalityProvider"
+	FinalityProviders_QueryVotes_FullMethodName                       = "/proto.FinalityProviders/QueryVotes"
+	FinalityProviders_ImportFinalityProvider_FullMethodName           = "/proto.FinalityProviders/ImportFinalityProvider"
+	FinalityProviders_RecoverFinalityProviderState_FullMethodName     = "/proto.FinalityProviders/RecoverFinalityProviderState"
+	FinalityProviders_RepairPubRandProofs_FullMethodName              = "/proto.FinalityProviders/RepairPubRandProofs"
 )
 
 // FinalityProvidersClient is the client API for FinalityProviders service.
@@ -55,6 +64,31 @@ type FinalityProvidersClient interface {
 	SignMessageFromChainKey(ctx context.Context, in *SignMessageFromChainKeyRequest, opts ...grpc.CallOption) (*SignMessageFromChainKeyResponse, error)
 	// EditFinalityProvider edits finality provider
 	EditFinalityProvider(ctx context.Context, in *EditFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// StartFinalityProvider starts the instance of a finality provider in the daemon
+	StartFinalityProvider(ctx context.Context, in *StartFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// StopFinalityProvider stops the running instance of a finality provider in the daemon
+	StopFinalityProvider(ctx context.Context, in *StopFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// QueryRunningFinalityProviderList queries the finality providers whose instances are
+	// running in the daemon
+	QueryRunningFinalityProviderList(ctx context.Context, in *QueryRunningFinalityProviderListRequest, opts ...grpc.CallOption) (*QueryRunningFinalityProviderListResponse, error)
+	// PauseFinalityProvider pauses a finality provider, which keeps committing
+	// public randomness but submits no finality signatures until it is resumed
+	PauseFinalityProvider(ctx context.Context, in *PauseFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ResumeFinalityProvider resumes a paused finality provider, and starts its
+	// instance if it is not running
+	ResumeFinalityProvider(ctx context.Context, in *ResumeFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// QueryVotes queries the votes of a finality provider recorded in the vote journal
+	QueryVotes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
+	// ImportFinalityProvider rebuilds the local record of a finality provider that has
+	// been registered on the consumer chain, e.g., from another node, from the chain
+	ImportFinalityProvider(ctx context.Context, in *ImportFinalityProviderRequest, opts ...grpc.CallOption) (*ImportFinalityProviderResponse, error)
+	// RecoverFinalityProviderState recovers the last voted and processed heights of
+	// a finality provider from the votes on the consumer chain, e.g., after the
+	// loss of the local db
+	RecoverFinalityProviderState(ctx context.Context, in *RecoverFinalityProviderStateRequest, opts ...grpc.CallOption) (*RecoverFinalityProviderStateResponse, error)
+	// RepairPubRandProofs regenerates the inclusion proofs of the public randomness
+	// committed on the consumer chain that are missing from the local db
+	RepairPubRandProofs(ctx context.Context, in *RepairPubRandProofsRequest, opts ...grpc.CallOption) (*RepairPubRandProofsResponse, error)
 }
 
 type finalityProvidersClient struct {
@@ -146,6 +180,87 @@ func (c *finalityProvidersClient) EditFinalityProvider(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *finalityProvidersClient) StartFinalityProvider(ctx context.Context, in *StartFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_StartFinalityProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) StopFinalityProvider(ctx context.Context, in *StopFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_StopFinalityProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) QueryRunningFinalityProviderList(ctx context.Context, in *QueryRunningFinalityProviderListRequest, opts ...grpc.CallOption) (*QueryRunningFinalityProviderListResponse, error) {
+	out := new(QueryRunningFinalityProviderListResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_QueryRunningFinalityProviderList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) PauseFinalityProvider(ctx context.Context, in *PauseFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_PauseFinalityProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) ResumeFinalityProvider(ctx context.Context, in *ResumeFinalityProviderRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_ResumeFinalityProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) QueryVotes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
+	out := new(QueryVotesResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_QueryVotes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) ImportFinalityProvider(ctx context.Context, in *ImportFinalityProviderRequest, opts ...grpc.CallOption) (*ImportFinalityProviderResponse, error) {
+	out := new(ImportFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_ImportFinalityProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) RecoverFinalityProviderState(ctx context.Context, in *RecoverFinalityProviderStateRequest, opts ...grpc.CallOption) (*RecoverFinalityProviderStateResponse, error) {
+	out := new(RecoverFinalityProviderStateResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_RecoverFinalityProviderState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) RepairPubRandProofs(ctx context.Context, in *RepairPubRandProofsRequest, opts ...grpc.CallOption) (*RepairPubRandProofsResponse, error) {
+	out := new(RepairPubRandProofsResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_RepairPubRandProofs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FinalityProvidersServer is the server API for FinalityProviders service.
 // All implementations must embed UnimplementedFinalityProvidersServer
 // for forward compatibility
@@ -171,6 +286,31 @@ type FinalityProvidersServer interface {
 	SignMessageFromChainKey(context.Context, *SignMessageFromChainKeyRequest) (*SignMessageFromChainKeyResponse, error)
 	// EditFinalityProvider edits finality provider
 	EditFinalityProvider(context.Context, *EditFinalityProviderRequest) (*EmptyResponse, error)
+	// StartFinalityProvider starts the instance of a finality provider in the daemon
+	StartFinalityProvider(context.Context, *StartFinalityProviderRequest) (*EmptyResponse, error)
+	// StopFinalityProvider stops the running instance of a finality provider in the daemon
+	StopFinalityProvider(context.Context, *StopFinalityProviderRequest) (*EmptyResponse, error)
+	// QueryRunningFinalityProviderList queries the finality providers whose instances are
+	// running in the daemon
+	QueryRunningFinalityProviderList(context.Context, *QueryRunningFinalityProviderListRequest) (*QueryRunningFinalityProviderListResponse, error)
+	// PauseFinalityProvider pauses a finality provider, which keeps committing
+	// public randomness but submits no finality signatures until it is resumed
+	PauseFinalityProvider(context.Context, *PauseFinalityProviderRequest) (*EmptyResponse, error)
+	// ResumeFinalityProvider resumes a paused finality provider, and starts its
+	// instance if it is not running
+	ResumeFinalityProvider(context.Context, *ResumeFinalityProviderRequest) (*EmptyResponse, error)
+	// QueryVotes queries the votes of a finality provider recorded in the vote journal
+	QueryVotes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
+	// ImportFinalityProvider rebuilds the local record of a finality provider that has
+	// been registered on the consumer chain, e.g., from another node, from the chain
+	ImportFinalityProvider(context.Context, *ImportFinalityProviderRequest) (*ImportFinalityProviderResponse, error)
+	// RecoverFinalityProviderState recovers the last voted and processed heights of
+	// a finality provider from the votes on the consumer chain, e.g., after the
+	// loss of the local db
+	RecoverFinalityProviderState(context.Context, *RecoverFinalityProviderStateRequest) (*RecoverFinalityProviderStateResponse, error)
+	// RepairPubRandProofs regenerates the inclusion proofs of the public randomness
+	// committed on the consumer chain that are missing from the local db
+	RepairPubRandProofs(context.Context, *RepairPubRandProofsRequest) (*RepairPubRandProofsResponse, error)
 	mustEmbedUnimplementedFinalityProvidersServer()
 }
 
@@ -205,6 +345,33 @@ func (UnimplementedFinalityProvidersServer) SignMessageFromChainKey(context.Cont
 func (UnimplementedFinalityProvidersServer) EditFinalityProvider(context.Context, *EditFinalityProviderRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditFinalityProvider not implemented")
 }
+func (UnimplementedFinalityProvidersServer) StartFinalityProvider(context.Context, *StartFinalityProviderRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartFinalityProvider not implemented")
+}
+func (UnimplementedFinalityProvidersServer) StopFinalityProvider(context.Context, *StopFinalityProviderRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopFinalityProvider not implemented")
+}
+func (UnimplementedFinalityProvidersServer) QueryRunningFinalityProviderList(context.Context, *QueryRunningFinalityProviderListRequest) (*QueryRunningFinalityProviderListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRunningFinalityProviderList not implemented")
+}
+func (UnimplementedFinalityProvidersServer) PauseFinalityProvider(context.Context, *PauseFinalityProviderRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseFinalityProvider not implemented")
+}
+func (UnimplementedFinalityProvidersServer) ResumeFinalityProvider(context.Context, *ResumeFinalityProviderRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeFinalityProvider not implemented")
+}
+func (UnimplementedFinalityProvidersServer) QueryVotes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVotes not implemented")
+}
+func (UnimplementedFinalityProvidersServer) ImportFinalityProvider(context.Context, *ImportFinalityProviderRequest) (*ImportFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFinalityProvider not implemented")
+}
+func (UnimplementedFinalityProvidersServer) RecoverFinalityProviderState(context.Context, *RecoverFinalityProviderStateRequest) (*RecoverFinalityProviderStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverFinalityProviderState not implemented")
+}
+func (UnimplementedFinalityProvidersServer) RepairPubRandProofs(context.Context, *RepairPubRandProofsRequest) (*RepairPubRandProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairPubRandProofs not implemented")
+}
 func (UnimplementedFinalityProvidersServer) mustEmbedUnimplementedFinalityProvidersServer() {}
 
 // UnsafeFinalityProvidersServer may be embedded to opt out of forward compatibility for this service.
@@ -380,6 +547,168 @@ func _FinalityProviders_EditFinalityProvider_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FinalityProviders_StartFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).StartFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_StartFinalityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).StartFinalityProvider(ctx, req.(*StartFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_StopFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).StopFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_StopFinalityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).StopFinalityProvider(ctx, req.(*StopFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_QueryRunningFinalityProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRunningFinalityProviderListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).QueryRunningFinalityProviderList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_QueryRunningFinalityProviderList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).QueryRunningFinalityProviderList(ctx, req.(*QueryRunningFinalityProviderListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_PauseFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).PauseFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_PauseFinalityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).PauseFinalityProvider(ctx, req.(*PauseFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_ResumeFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).ResumeFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_ResumeFinalityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).ResumeFinalityProvider(ctx, req.(*ResumeFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_QueryVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).QueryVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_QueryVotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).QueryVotes(ctx, req.(*QueryVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_ImportFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).ImportFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_ImportFinalityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).ImportFinalityProvider(ctx, req.(*ImportFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_RecoverFinalityProviderState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverFinalityProviderStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).RecoverFinalityProviderState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_RecoverFinalityProviderState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).RecoverFinalityProviderState(ctx, req.(*RecoverFinalityProviderStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_RepairPubRandProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairPubRandProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).RepairPubRandProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_RepairPubRandProofs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).RepairPubRandProofs(ctx, req.(*RepairPubRandProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FinalityProviders_ServiceDesc is the grpc.ServiceDesc for FinalityProviders service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -423,6 +752,42 @@ var FinalityProviders_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditFinalityProvider",
 			Handler:    _FinalityProviders_EditFinalityProvider_Handler,
 		},
+		{
+			MethodName: "StartFinalityProvider",
+			Handler:    _FinalityProviders_StartFinalityProvider_Handler,
+		},
+		{
+			MethodName: "StopFinalityProvider",
+			Handler:    _FinalityProviders_StopFinalityProvider_Handler,
+		},
+		{
+			MethodName: "QueryRunningFinalityProviderList",
+			Handler:    _FinalityProviders_QueryRunningFinalityProviderList_Handler,
+		},
+		{
+			MethodName: "PauseFinalityProvider",
+			Handler:    _FinalityProviders_PauseFinalityProvider_Handler,
+		},
+		{
+			MethodName: "ResumeFinalityProvider",
+			Handler:    _FinalityProviders_ResumeFinalityProvider_Handler,
+		},
+		{
+			MethodName: "QueryVotes",
+			Handler:    _FinalityProviders_QueryVotes_Handler,
+		},
+		{
+			MethodName: "ImportFinalityProvider",
+			Handler:    _FinalityProviders_ImportFinalityProvider_Handler,
+		},
+		{
+			MethodName: "RecoverFinalityProviderState",
+			Handler:    _FinalityProviders_RecoverFinalityProviderState_Handler,
+		},
+		{
+			MethodName: "RepairPubRandProofs",
+			Handler:    _FinalityProviders_RepairPubRandProofs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finality_providers.proto",
@@ -0,0 +1,9 @@
+package proto
+
+import _ "embed"
+
+// OpenAPISpec is the OpenAPI spec of the REST gateway of the finality provider daemon, which
+// is generated from finality_providers.proto and the routes in finality_providers_gateway.yaml
+//
+//go:embed finality_providers.swagger.json
+var OpenAPISpec []byte
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -9,17 +10,18 @@ import (
 	sdkmath "cosmossdk.io/math"
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
-	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/connectivity"
 
 	"github.com/babylonlabs-io/finality-provider/clientcontroller"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+	eotstypes "github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
@@ -39,6 +41,7 @@ type FinalityProviderApp struct {
 	kr           keyring.Keyring
 	fps          *store.FinalityProviderStore
 	pubRandStore *store.PubRandProofStore
+	voteJournal  *store.VoteJournalStore
 	config       *fpcfg.Config
 	logger       *zap.Logger
 	input        *strings.Reader
@@ -65,13 +68,47 @@ func NewFinalityProviderAppFromConfig(
 
 	// if the EOTSManagerAddress is empty, run a local EOTS manager;
 	// otherwise connect a remote one with a gRPC client
-	em, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress)
+	var em eotsmanager.EOTSManager
+	if cfg.EOTSManagerAddress == "" {
+		em, err = newInProcessEOTSManager(cfg.EOTSManagerConfig, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create local EOTS manager: %w", err)
+		}
+		logger.Info("successfully created a local EOTS manager", zap.String("key_dir", cfg.EOTSManagerConfig.KeyDirectory))
+	} else {
+		emClient, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress, cfg.EOTSManagerAuth, cfg.EOTSManagerClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create EOTS manager client: %w", err)
+		}
+		info, err := emClient.Info()
+		if err != nil {
+			_ = emClient.Close()
+			return nil, fmt.Errorf("failed to get the EOTS manager info: %w", err)
+		}
+		if err := client.CheckCompatibility(info); err != nil {
+			_ = emClient.Close()
+			return nil, fmt.Errorf("incompatible EOTS manager at %s: %w", cfg.EOTSManagerAddress, err)
+		}
+		fpMetrics := metrics.NewFpMetrics()
+		emClient.WatchConnectivity(func(state connectivity.State) {
+			fpMetrics.RecordEOTSManagerConnectivityState(state)
+			logger.Info("the connectivity state of the EOTS manager changed", zap.String("state", state.String()))
+		})
+		em = emClient
+		logger.Info("successfully connected to a remote EOTS manager",
+			zap.String("address", cfg.EOTSManagerAddress),
+			zap.String("version", info.Version),
+			zap.String("commit", info.Commit),
+		)
+	}
+
+	app, err := NewFinalityProviderApp(cfg, cc, em, db, logger)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create EOTS manager client: %w", err)
+		_ = em.Close()
+		return nil, err
 	}
 
-	logger.Info("successfully connected to a remote EOTS manager", zap.String("address", cfg.EOTSManagerAddress))
-	return NewFinalityProviderApp(cfg, cc, em, db, logger)
+	return app, nil
 }
 
 func NewFinalityProviderApp(
@@ -89,6 +126,10 @@ func NewFinalityProviderApp(
 	if err != nil {
 		return nil, fmt.Errorf("failed to initiate public randomness store: %w", err)
 	}
+	voteJournal, err := store.NewVoteJournalStore(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initiate vote journal store: %w", err)
+	}
 
 	input := strings.NewReader("")
 	kr, err := fpkr.CreateKeyring(
@@ -103,7 +144,7 @@ func NewFinalityProviderApp(
 
 	fpMetrics := metrics.NewFpMetrics()
 
-	fpm, err := NewFinalityProviderManager(fpStore, pubRandStore, config, cc, em, fpMetrics, logger)
+	fpm, err := NewFinalityProviderManager(fpStore, pubRandStore, voteJournal, config, cc, em, fpMetrics, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create finality-provider manager: %w", err)
 	}
@@ -112,6 +153,7 @@ func NewFinalityProviderApp(
 		cc:                                  cc,
 		fps:                                 fpStore,
 		pubRandStore:                        pubRandStore,
+		voteJournal:                         voteJournal,
 		kr:                                  kr,
 		config:                              config,
 		logger:                              logger,
@@ -138,6 +180,10 @@ func (app *FinalityProviderApp) GetPubRandProofStore() *store.PubRandProofStore
 	return app.pubRandStore
 }
 
+func (app *FinalityProviderApp) GetVoteJournalStore() *store.VoteJournalStore {
+	return app.voteJournal
+}
+
 func (app *FinalityProviderApp) GetKeyring() keyring.Keyring {
 	return app.kr
 }
@@ -159,9 +205,15 @@ func (app *FinalityProviderApp) GetFinalityProviderInfo(fpPk *bbntypes.BIP340Pub
 	return app.fpManager.FinalityProviderInfo(fpPk)
 }
 
-// GetFinalityProviderInstance returns the finality-provider instance with the given Babylon public key
-func (app *FinalityProviderApp) GetFinalityProviderInstance() (*FinalityProviderInstance, error) {
-	return app.fpManager.GetFinalityProviderInstance()
+// GetFinalityProviderInstance returns the finality-provider instance with the given BTC public key
+func (app *FinalityProviderApp) GetFinalityProviderInstance(fpPk *bbntypes.BIP340PubKey) (*FinalityProviderInstance, error) {
+	return app.fpManager.GetFinalityProviderInstance(fpPk)
+}
+
+// ListRunningFinalityProvidersInfo returns the information of the finality providers whose
+// instances are running
+func (app *FinalityProviderApp) ListRunningFinalityProvidersInfo() ([]*proto.FinalityProviderInfo, error) {
+	return app.fpManager.RunningFinalityProviders()
 }
 
 func (app *FinalityProviderApp) RegisterFinalityProvider(fpPkStr string) (*RegisterFinalityProviderResponse, error) {
@@ -222,29 +274,96 @@ func (app *FinalityProviderApp) StartHandlingFinalityProvider(fpPk *bbntypes.BIP
 	return app.fpManager.StartFinalityProvider(fpPk, passphrase)
 }
 
-// NOTE: this is not safe in production, so only used for testing purpose
-func (app *FinalityProviderApp) getFpPrivKey(fpPk []byte) (*btcec.PrivateKey, error) {
-	record, err := app.eotsManager.KeyRecord(fpPk, "")
+// StopHandlingFinalityProvider stops the finality provider instance with the given EOTS public key,
+// which is not started by the status sync until it is started again
+func (app *FinalityProviderApp) StopHandlingFinalityProvider(fpPk *bbntypes.BIP340PubKey) error {
+	return app.fpManager.StopFinalityProvider(fpPk)
+}
+
+// PauseHandlingFinalityProvider pauses the finality provider with the given EOTS public key,
+// which keeps committing public randomness but submits no finality signatures
+func (app *FinalityProviderApp) PauseHandlingFinalityProvider(fpPk *bbntypes.BIP340PubKey) error {
+	return app.fpManager.PauseFinalityProvider(fpPk)
+}
+
+// ResumeHandlingFinalityProvider resumes the paused finality provider with the given EOTS public
+// key, and starts its instance if it is not running
+func (app *FinalityProviderApp) ResumeHandlingFinalityProvider(fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	return app.fpManager.ResumeFinalityProvider(fpPk, passphrase)
+}
+
+// RecoverFinalityProviderState recovers the last voted and processed heights of the finality
+// provider with the given EOTS public key from its votes on the consumer chain, e.g., after
+// the loss of the local db, and returns the stored finality provider after the recovery
+func (app *FinalityProviderApp) RecoverFinalityProviderState(fpPk *bbntypes.BIP340PubKey) (*store.StoredFinalityProvider, error) {
+	return app.fpManager.RecoverFinalityProviderState(fpPk)
+}
+
+// RepairPubRandProofs regenerates the inclusion proofs of the public randomness committed by the
+// finality provider with the given EOTS public key that are missing from the local db, e.g., after
+// the loss of the db. The passphrase is only used if the finality provider is not running
+func (app *FinalityProviderApp) RepairPubRandProofs(fpPk *bbntypes.BIP340PubKey, passphrase string) (*PubRandRepairResult, error) {
+	return app.fpManager.RepairPubRandProofs(fpPk, passphrase)
+}
+
+// QueryVotes returns the votes of the finality provider recorded in the vote journal from the
+// start height, and the start height of the next page, which is 0 if there are no more votes
+func (app *FinalityProviderApp) QueryVotes(
+	fpPk *bbntypes.BIP340PubKey,
+	startHeight uint64,
+	limit uint32,
+	reverse bool,
+) ([]*proto.VoteRecord, uint64, error) {
+	if _, err := app.fps.GetFinalityProvider(fpPk.MustToBTCPK()); err != nil {
+		return nil, 0, fmt.Errorf("failed to get finality provider from db: %w", err)
+	}
+
+	return app.voteJournal.QueryVoteRecords(fpPk.MustToBTCPK(), startHeight, limit, reverse)
+}
+
+// createPop creates the proof of possession of the EOTS key over the given address, which
+// is signed by the EOTS manager as the private key never leaves it
+func (app *FinalityProviderApp) createPop(
+	fpAddr sdk.AccAddress,
+	fpPk *bbntypes.BIP340PubKey,
+	passphrase string,
+) (*bstypes.ProofOfPossessionBTC, error) {
+	payload := &eotstypes.SchnorrPayload{PoPAddress: fpAddr.Bytes()}
+	sig, err := app.eotsManager.SignSchnorrSigForPayload(fpPk.MustMarshal(), payload, passphrase)
 	if err != nil {
 		return nil, err
 	}
 
-	return record.PrivKey, nil
+	return &bstypes.ProofOfPossessionBTC{
+		BtcSigType: bstypes.BTCSigType_BIP340,
+		BtcSig:     bbntypes.NewBIP340SignatureFromBTCSig(sig).MustMarshal(),
+	}, nil
 }
 
-// SyncFinalityProviderStatus syncs the status of the finality-providers with the chain.
-func (app *FinalityProviderApp) SyncFinalityProviderStatus() (fpInstanceRunning bool, err error) {
+// SyncFinalityProviderStatus syncs the status of the finality-providers that are not running with
+// the chain, and starts those that should start. The finality providers stopped explicitly are
+// skipped, the paused ones are not started, and failing to start one finality provider does not
+// prevent starting the others
+func (app *FinalityProviderApp) SyncFinalityProviderStatus() error {
 	latestBlock, err := app.cc.QueryBestBlock()
 	if err != nil {
-		return false, err
+		return err
 	}
 
 	fps, err := app.fps.GetAllStoredFinalityProviders()
 	if err != nil {
-		return false, err
+		return err
 	}
 
+	var startErrs error
 	for _, fp := range fps {
+		bip340PubKey := fp.GetBIP340BTCPK()
+		if app.fpManager.IsFinalityProviderRunning(bip340PubKey) ||
+			app.fpManager.isFinalityProviderStopped(bip340PubKey) {
+			// if it is already running or stopped on purpose, no need to update status
+			continue
+		}
+
 		vp, err := app.cc.QueryFinalityProviderVotingPower(fp.BtcPk, latestBlock.Height)
 		if err != nil {
 			// if ther error is that there is nothing in the voting power table
@@ -257,18 +376,10 @@ func (app *FinalityProviderApp) SyncFinalityProviderStatus() (fpInstanceRunning
 			}
 		}
 
-		bip340PubKey := fp.GetBIP340BTCPK()
-		if app.fpManager.IsFinalityProviderRunning(bip340PubKey) {
-			// there is a instance running, no need to keep syncing
-			fpInstanceRunning = true
-			// if it is already running, no need to update status
-			continue
-		}
-
 		oldStatus := fp.Status
 		newStatus, err := app.fps.UpdateFpStatusFromVotingPower(vp, fp)
 		if err != nil {
-			return false, err
+			return err
 		}
 
 		if oldStatus != newStatus {
@@ -281,17 +392,17 @@ func (app *FinalityProviderApp) SyncFinalityProviderStatus() (fpInstanceRunning
 			fp.Status = newStatus
 		}
 
-		if !fp.ShouldStart() {
+		if !fp.ShouldStart() || fp.Paused {
 			continue
 		}
 
 		if err := app.fpManager.StartFinalityProvider(bip340PubKey, ""); err != nil {
-			return false, err
+			startErrs = errors.Join(startErrs, fmt.Errorf("failed to start finality provider %s: %w",
+				bip340PubKey.MarshalHex(), err))
 		}
-		fpInstanceRunning = true
 	}
 
-	return fpInstanceRunning, nil
+	return startErrs
 }
 
 // Start starts only the finality-provider daemon without any finality-provider instances
@@ -305,6 +416,16 @@ func (app *FinalityProviderApp) Start() error {
 		go app.eventLoop()
 		go app.registrationLoop()
 		go app.metricsUpdateLoop()
+
+		if app.config.VoteJournal != nil && app.config.VoteJournal.Retention > 0 {
+			app.wg.Add(1)
+			go app.voteJournalPruneLoop()
+		}
+
+		if app.config.PubRandPruning != nil && app.config.PubRandPruning.PruneInterval > 0 {
+			app.wg.Add(1)
+			go app.pubRandPruneLoop()
+		}
 	})
 
 	return startErr
@@ -332,6 +453,13 @@ func (app *FinalityProviderApp) Stop() error {
 			return
 		}
 
+		// the client controller is shared by the pollers of the finality providers
+		app.logger.Debug("Closing the consumer chain client")
+		if err := app.cc.Close(); err != nil {
+			stopErr = err
+			return
+		}
+
 		app.logger.Debug("FinalityProviderApp successfully stopped")
 
 	})
@@ -402,6 +530,130 @@ func (app *FinalityProviderApp) UnjailFinalityProvider(fpPk *bbntypes.BIP340PubK
 	return res.TxHash, nil
 }
 
+// ImportFinalityProvider rebuilds the local record of a finality provider that has been registered
+// on the consumer chain with the given EOTS public key, e.g., when moving it to a fresh fpd home.
+// The EOTS key must be managed by the EOTS manager and the chain key of the given name must be the
+// address of the registered finality provider. The status and the last voted and processed heights
+// are recovered from the consumer chain. The key name and the chain ID default to the ones in the config
+func (app *FinalityProviderApp) ImportFinalityProvider(
+	keyName, chainID, passphrase string,
+	eotsPk *bbntypes.BIP340PubKey,
+) (*proto.FinalityProviderInfo, error) {
+	if keyName == "" {
+		keyName = app.config.BabylonConfig.Key
+	}
+	if chainID == "" {
+		chainID = app.config.BabylonConfig.ChainID
+	}
+
+	fpPk := eotsPk.MustToBTCPK()
+	if _, err := app.fps.GetFinalityProvider(fpPk); err == nil {
+		return nil, fmt.Errorf("%w: %s", store.ErrDuplicateFinalityProvider, eotsPk.MarshalHex())
+	}
+
+	// 1. query the registered finality provider
+	res, err := app.cc.QueryFinalityProvider(fpPk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the finality provider %s from the consumer chain: %w", eotsPk.MarshalHex(), err)
+	}
+	chainFp := res.FinalityProvider
+	if chainFp == nil || chainFp.Description == nil || chainFp.Commission == nil || chainFp.Pop == nil {
+		return nil, fmt.Errorf("the finality provider %s is not properly registered on the consumer chain", eotsPk.MarshalHex())
+	}
+
+	// 2. check if the EOTS key is managed by the EOTS manager by signing a proof of possession
+	// with it, which is allowed by the signing policies restricting Schnorr signatures
+	chainFpAddr, err := sdk.AccAddressFromBech32(chainFp.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s of the finality provider %s: %w", chainFp.Addr, eotsPk.MarshalHex(), err)
+	}
+	pop, err := app.createPop(chainFpAddr, eotsPk, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("the EOTS key %s is not available in the EOTS manager: %w", eotsPk.MarshalHex(), err)
+	}
+	if err := pop.VerifyBIP340(chainFpAddr, eotsPk); err != nil {
+		return nil, fmt.Errorf("the EOTS manager does not hold the EOTS key %s: %w", eotsPk.MarshalHex(), err)
+	}
+
+	// 3. check if the chain key is the address of the registered finality provider
+	kr, err := fpkr.NewChainKeyringControllerWithKeyring(app.kr, keyName, app.input)
+	if err != nil {
+		return nil, err
+	}
+	fpAddr, err := kr.Address(passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the address of the chain key %s: %w", keyName, err)
+	}
+	if fpAddr.String() != chainFp.Addr {
+		return nil, fmt.Errorf("the address %s of the chain key %s does not match the address %s of the finality provider %s",
+			fpAddr.String(), keyName, chainFp.Addr, eotsPk.MarshalHex())
+	}
+
+	// 4. recover the state from the votes on the consumer chain
+	state, err := recoverStateFromChain(app.cc, eotsPk, 0, stateRecoveryScanWindow(app.config), app.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	desBytes, err := chainFp.Description.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("invalid description: %w", err)
+	}
+	status := fpStatusFromChain(chainFp, state.lastVotedHeight)
+	fp := &proto.FinalityProvider{
+		FpAddr:      chainFp.Addr,
+		BtcPk:       eotsPk.MustMarshal(),
+		Description: desBytes,
+		Commission:  chainFp.Commission.String(),
+		Pop: &proto.ProofOfPossession{
+			BtcSig: chainFp.Pop.BtcSig,
+		},
+		KeyName:             keyName,
+		ChainId:             chainID,
+		Status:              status,
+		LastVotedHeight:     state.lastVotedHeight,
+		LastProcessedHeight: state.lastProcessedHeight,
+	}
+	if err := app.fps.ImportFinalityProvider(fp); err != nil {
+		return nil, fmt.Errorf("failed to save finality-provider: %w", err)
+	}
+	app.fpManager.metrics.RecordFpStatus(eotsPk.MarshalHex(), status)
+
+	app.logger.Info("successfully imported a finality-provider",
+		zap.String("btc_pk", eotsPk.MarshalHex()),
+		zap.String("fp_addr", chainFp.Addr),
+		zap.String("key_name", keyName),
+		zap.String("status", status.String()),
+		zap.Uint64("last_voted_height", state.lastVotedHeight),
+		zap.Uint64("last_processed_height", state.lastProcessedHeight),
+	)
+
+	storedFp, err := app.fps.GetFinalityProvider(fpPk)
+	if err != nil {
+		return nil, err
+	}
+
+	return storedFp.ToFinalityProviderInfo(), nil
+}
+
+// fpStatusFromChain returns the status of a registered finality provider
+// given its record on the consumer chain and its last voted height
+func fpStatusFromChain(chainFp *bstypes.FinalityProviderResponse, lastVotedHeight uint64) proto.FinalityProviderStatus {
+	switch {
+	case chainFp.SlashedBtcHeight > 0:
+		return proto.FinalityProviderStatus_SLASHED
+	case chainFp.Jailed:
+		return proto.FinalityProviderStatus_JAILED
+	case chainFp.VotingPower > 0:
+		return proto.FinalityProviderStatus_ACTIVE
+	case lastVotedHeight > 0:
+		// it has had voting power before
+		return proto.FinalityProviderStatus_INACTIVE
+	default:
+		return proto.FinalityProviderStatus_REGISTERED
+	}
+}
+
 func (app *FinalityProviderApp) handleCreateFinalityProviderRequest(req *createFinalityProviderRequest) (*createFinalityProviderResponse, error) {
 	// 1. check if the chain key exists
 	kr, err := fpkr.NewChainKeyringControllerWithKeyring(app.kr, req.keyName, app.input)
@@ -432,13 +684,8 @@ func (app *FinalityProviderApp) handleCreateFinalityProviderRequest(req *createF
 		}
 	}
 
-	fpRecord, err := app.eotsManager.KeyRecord(fpPk.MustMarshal(), req.passPhrase)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get finality-provider record: %w", err)
-	}
-
 	// 3. create proof-of-possession
-	pop, err := kr.CreatePop(fpAddr, fpRecord.PrivKey)
+	pop, err := app.createPop(fpAddr, fpPk, req.passPhrase)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create proof-of-possession of the finality-provider: %w", err)
 	}
@@ -530,13 +777,8 @@ func (app *FinalityProviderApp) StoreFinalityProvider(
 	if err != nil {
 		return nil, err
 	}
-	fpRecord, err := app.eotsManager.KeyRecord(fpPk.MustMarshal(), passPhrase)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get finality-provider record: %w", err)
-	}
-
 	// 3. create proof-of-possession
-	pop, err := kr.CreatePop(fpAddr, fpRecord.PrivKey)
+	pop, err := app.createPop(fpAddr, fpPk, passPhrase)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create proof-of-possession of the finality provider: %w", err)
 	}
@@ -703,12 +945,144 @@ func (app *FinalityProviderApp) metricsUpdateLoop() {
 	}
 }
 
+// voteJournalPruneLoop periodically deletes the votes older than the retention from the
+// vote journal
+func (app *FinalityProviderApp) voteJournalPruneLoop() {
+	defer app.wg.Done()
+
+	cfg := app.config.VoteJournal
+	app.logger.Info("starting vote journal prune loop",
+		zap.Float64("interval seconds", cfg.PruneInterval.Seconds()),
+		zap.Duration("retention", cfg.Retention),
+	)
+	pruneTicker := time.NewTicker(cfg.PruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-pruneTicker.C:
+			pruned, err := app.voteJournal.PruneVoteRecords(time.Now().Add(-cfg.Retention))
+			if err != nil {
+				app.logger.Error("failed to prune the vote journal", zap.Error(err))
+				continue
+			}
+			if pruned > 0 {
+				app.logger.Info("pruned the vote journal", zap.Uint64("num_votes", pruned))
+			}
+		case <-app.quit:
+			app.logger.Info("exiting vote journal prune loop")
+			return
+		}
+	}
+}
+
+// pubRandPruneLoop periodically deletes the public randomness proofs and the signed blocks of
+// the finality providers below the last finalized height minus the safety margin, which are
+// never used again
+func (app *FinalityProviderApp) pubRandPruneLoop() {
+	defer app.wg.Done()
+
+	cfg := app.config.PubRandPruning
+	app.logger.Info("starting public randomness prune loop",
+		zap.Float64("interval seconds", cfg.PruneInterval.Seconds()),
+		zap.Uint64("safety_margin", cfg.SafetyMargin),
+	)
+	pruneTicker := time.NewTicker(cfg.PruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-pruneTicker.C:
+			if err := app.prunePubRandProofs(cfg); err != nil {
+				app.logger.Error("failed to prune the public randomness proofs", zap.Error(err))
+			}
+		case <-app.quit:
+			app.logger.Info("exiting public randomness prune loop")
+			return
+		}
+	}
+}
+
+func (app *FinalityProviderApp) prunePubRandProofs(cfg *fpcfg.PubRandPruningConfig) error {
+	// the proofs saved before they were indexed by height are never needed again, as the
+	// finality providers regenerate the proofs for the heights yet to be voted at startup
+	deleted, err := app.pubRandStore.DeleteLegacyPubRandProofs()
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		app.logger.Info("deleted the public randomness proofs saved before they were indexed by height",
+			zap.Uint64("num_proofs", deleted),
+		)
+	}
+
+	belowHeight, err := pubRandPruneHeight(app.cc, cfg)
+	if err != nil {
+		return err
+	}
+	if belowHeight == 0 {
+		return nil
+	}
+
+	fps, err := app.fps.GetAllStoredFinalityProviders()
+	if err != nil {
+		return err
+	}
+
+	for _, fp := range fps {
+		pruned, err := app.pubRandStore.PrunePubRandProofs(fp.BtcPk, []byte(fp.ChainID), belowHeight)
+		if err != nil {
+			return err
+		}
+		if pruned > 0 {
+			app.logger.Info("pruned the public randomness proofs",
+				zap.String("pk", fp.GetBIP340BTCPK().MarshalHex()),
+				zap.Uint64("below_height", belowHeight),
+				zap.Uint64("num_proofs", pruned),
+			)
+		}
+
+		// the blocks below the height are finalized, so they are never signed again
+		pruned, err = app.fps.PruneSignedBlocks(fp.BtcPk, belowHeight)
+		if err != nil {
+			return err
+		}
+		if pruned > 0 {
+			app.logger.Info("pruned the signed blocks",
+				zap.String("pk", fp.GetBIP340BTCPK().MarshalHex()),
+				zap.Uint64("below_height", belowHeight),
+				zap.Uint64("num_blocks", pruned),
+			)
+		}
+	}
+
+	return nil
+}
+
+// pubRandPruneHeight returns the height below which the public randomness proofs are pruned,
+// which is the last finalized height minus the safety margin, or 0 if the pruning is disabled
+func pubRandPruneHeight(cc clientcontroller.ClientController, cfg *fpcfg.PubRandPruningConfig) (uint64, error) {
+	if cfg == nil || cfg.PruneInterval <= 0 {
+		return 0, nil
+	}
+
+	finalizedBlocks, err := cc.QueryLatestFinalizedBlocks(1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query the latest finalized block: %w", err)
+	}
+	if len(finalizedBlocks) == 0 || finalizedBlocks[0].Height <= cfg.SafetyMargin {
+		return 0, nil
+	}
+
+	return finalizedBlocks[0].Height - cfg.SafetyMargin, nil
+}
+
 // syncChainFpStatusLoop keeps querying the chain for the finality
 // provider voting power and update the FP status accordingly.
 // If there is some voting power it sets to active, for zero voting power
 // it goes from: CREATED -> REGISTERED or ACTIVE -> INACTIVE.
-// if there is any node running or a new finality provider instance
-// is started, the loop stops.
+// The finality providers that should start are started, so the loop keeps
+// running to start the finality providers registered later.
 func (app *FinalityProviderApp) syncChainFpStatusLoop() {
 	defer app.wg.Done()
 
@@ -723,13 +1097,9 @@ func (app *FinalityProviderApp) syncChainFpStatusLoop() {
 	for {
 		select {
 		case <-syncFpStatusTicker.C:
-			fpInstanceStarted, err := app.SyncFinalityProviderStatus()
-			if err != nil {
+			if err := app.SyncFinalityProviderStatus(); err != nil {
 				app.Logger().Error("failed to sync finality-provider status", zap.Error(err))
 			}
-			if fpInstanceStarted {
-				return
-			}
 
 		case <-app.quit:
 			app.logger.Info("exiting sync FP status loop")
@@ -13,6 +13,8 @@ import (
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -22,7 +24,9 @@ import (
 	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
 	"github.com/babylonlabs-io/finality-provider/testutil"
+	"github.com/babylonlabs-io/finality-provider/testutil/mocks"
 	"github.com/babylonlabs-io/finality-provider/types"
 )
 
@@ -106,6 +110,9 @@ func FuzzRegisterFinalityProvider(f *testing.F) {
 			BtcSig:     btcSig.MustMarshal(),
 			BtcSigType: bstypes.BTCSigType_BIP340,
 		}
+		// the proof of possession is signed by the EOTS manager
+		err = pop.VerifyBIP340(sdk.MustAccAddressFromBech32(fp.FPAddr), fp.GetBIP340BTCPK())
+		require.NoError(t, err)
 		popBytes, err := pop.Marshal()
 		require.NoError(t, err)
 		fpInfo, err := app.GetFinalityProviderInfo(fp.GetBIP340BTCPK())
@@ -130,10 +137,12 @@ func FuzzRegisterFinalityProvider(f *testing.F) {
 		require.Equal(t, txHash, res.TxHash)
 
 		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), uint64(1)).Return(nil, nil).AnyTimes()
+		// no public randomness is committed, so there is no proof to repair at startup
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 		err = app.StartHandlingFinalityProvider(fp.GetBIP340BTCPK(), passphrase)
 		require.NoError(t, err)
 
-		fpAfterReg, err := app.GetFinalityProviderInstance()
+		fpAfterReg, err := app.GetFinalityProviderInstance(fp.GetBIP340BTCPK())
 		require.NoError(t, err)
 		require.Equal(t, proto.FinalityProviderStatus_REGISTERED, fpAfterReg.GetStoreFinalityProvider().Status)
 
@@ -177,6 +186,8 @@ func FuzzSyncFinalityProviderStatus(f *testing.F) {
 		blkInfo := &types.BlockInfo{Height: currentHeight}
 
 		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), uint64(1)).Return(nil, nil).AnyTimes()
+		// no public randomness is committed, so there is no proof to repair at startup
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
 		mockClientController.EXPECT().QueryBestBlock().Return(blkInfo, nil).Return(blkInfo, nil).AnyTimes()
 		mockClientController.EXPECT().QueryBlock(gomock.Any()).Return(nil, errors.New("chain not online")).AnyTimes()
@@ -210,7 +221,7 @@ func FuzzSyncFinalityProviderStatus(f *testing.F) {
 			if noVotingPowerTable {
 				expectedStatus = proto.FinalityProviderStatus_REGISTERED
 			}
-			fpInstance, err := app.GetFinalityProviderInstance()
+			fpInstance, err := app.GetFinalityProviderInstance(fpPk)
 			if err != nil {
 				return false
 			}
@@ -256,6 +267,8 @@ func FuzzUnjailFinalityProvider(f *testing.F) {
 		blkInfo := &types.BlockInfo{Height: currentHeight}
 
 		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), uint64(1)).Return(nil, nil).AnyTimes()
+		// no public randomness is committed, so there is no proof to repair at startup
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
 		mockClientController.EXPECT().QueryBestBlock().Return(blkInfo, nil).Return(blkInfo, nil).AnyTimes()
 		mockClientController.EXPECT().QueryBlock(gomock.Any()).Return(nil, errors.New("chain not online")).AnyTimes()
@@ -289,3 +302,134 @@ func FuzzUnjailFinalityProvider(f *testing.F) {
 		require.Equal(t, proto.FinalityProviderStatus_INACTIVE.String(), fpInfo.GetStatus())
 	})
 }
+
+func FuzzImportFinalityProvider(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		logger := zap.NewNop()
+
+		pathSuffix := datagen.GenRandomHexStr(r, 10)
+		// create an EOTS manager
+		eotsHomeDir := filepath.Join(t.TempDir(), "eots-home", pathSuffix)
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(eotsHomeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, dbBackend.Close())
+		}()
+		em, err := eotsmanager.NewLocalEOTSManager(eotsHomeDir, eotsCfg.KeyringBackend, dbBackend, logger)
+		require.NoError(t, err)
+		// importing works with the recommended signing policy restricting Schnorr signatures
+		policy := eotscfg.DefaultPolicyConfig()
+		policy.RestrictSchnorr["*"] = true
+		require.NoError(t, em.SetSigningPolicy(policy))
+
+		// the finality provider has voted at the voted height only if it has voted
+		finalizedHeight := uint64(r.Int63n(50) + 1)
+		tipHeight := finalizedHeight + uint64(r.Int63n(50)+10)
+		hasVoted := r.Intn(2) == 0
+		votedHeight := uint64(0)
+		if hasVoted {
+			votedHeight = finalizedHeight + 1 + uint64(r.Int63n(int64(tipHeight-finalizedHeight)))
+		}
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().
+			Return(&types.BlockInfo{Height: tipHeight, Hash: testutil.GenRandomByteArray(r, 32)}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(uint64(1)).
+			Return([]*types.BlockInfo{{Height: finalizedHeight, Hash: testutil.GenRandomByteArray(r, 32)}}, nil).AnyTimes()
+
+		// Create randomized config
+		fpHomeDir := filepath.Join(t.TempDir(), "fp-home", pathSuffix)
+		fpCfg := config.DefaultConfigWithHome(fpHomeDir)
+		fpdb, err := fpCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, fpdb.Close())
+		}()
+		app, err := service.NewFinalityProviderApp(&fpCfg, mockClientController, em, fpdb, logger)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, app.Stop())
+		}()
+
+		// the keys of the registered finality provider are available in the fresh homes
+		keyName := datagen.GenRandomHexStr(r, 4)
+		chainKey, err := service.CreateChainKey(fpCfg.BabylonConfig.KeyDirectory, fpCfg.BabylonConfig.ChainID, keyName, keyring.BackendTest, passphrase, hdPath, "")
+		require.NoError(t, err)
+		eotsPkBz, err := em.CreateKey(keyName, passphrase, hdPath)
+		require.NoError(t, err)
+		eotsPk, err := bbntypes.NewBIP340PubKey(eotsPkBz)
+		require.NoError(t, err)
+
+		mockClientController.EXPECT().QueryVotesAtHeight(gomock.Any()).DoAndReturn(func(height uint64) ([]bbntypes.BIP340PubKey, error) {
+			if height == votedHeight {
+				return []bbntypes.BIP340PubKey{*eotsPk}, nil
+			}
+			return nil, nil
+		}).AnyTimes()
+
+		chainFp := &bstypes.FinalityProviderResponse{
+			Description: testutil.RandomDescription(r),
+			Commission:  testutil.ZeroCommissionRate(),
+			Addr:        chainKey.AccAddress.String(),
+			BtcPk:       eotsPk,
+			Pop: &bstypes.ProofOfPossessionBTC{
+				BtcSigType: bstypes.BTCSigType_BIP340,
+				BtcSig:     datagen.GenRandomByteArray(r, 64),
+			},
+		}
+		expectedStatus := proto.FinalityProviderStatus_REGISTERED
+		if hasVoted {
+			expectedStatus = proto.FinalityProviderStatus_INACTIVE
+		}
+		switch r.Intn(4) {
+		case 0:
+			chainFp.SlashedBtcHeight = uint32(r.Int63n(100) + 1)
+			expectedStatus = proto.FinalityProviderStatus_SLASHED
+		case 1:
+			chainFp.Jailed = true
+			expectedStatus = proto.FinalityProviderStatus_JAILED
+		case 2:
+			chainFp.VotingPower = uint64(r.Int63n(100) + 1)
+			expectedStatus = proto.FinalityProviderStatus_ACTIVE
+		}
+		mockClientController.EXPECT().QueryFinalityProvider(gomock.Any()).
+			Return(&bstypes.QueryFinalityProviderResponse{FinalityProvider: chainFp}, nil).AnyTimes()
+
+		// the chain key must be the address of the registered finality provider
+		otherKeyName := datagen.GenRandomHexStr(r, 5)
+		_, err = service.CreateChainKey(fpCfg.BabylonConfig.KeyDirectory, fpCfg.BabylonConfig.ChainID, otherKeyName, keyring.BackendTest, passphrase, hdPath, "")
+		require.NoError(t, err)
+		_, err = app.ImportFinalityProvider(otherKeyName, "", passphrase, eotsPk)
+		require.ErrorContains(t, err, "does not match")
+
+		// the EOTS key must be managed by the EOTS manager
+		_, unknownPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, err = app.ImportFinalityProvider(keyName, "", passphrase, bbntypes.NewBIP340PubKeyFromBTCPK(unknownPk))
+		require.Error(t, err)
+
+		fpInfo, err := app.ImportFinalityProvider(keyName, "", passphrase, eotsPk)
+		require.NoError(t, err)
+		require.Equal(t, eotsPk.MarshalHex(), fpInfo.BtcPkHex)
+		require.Equal(t, chainFp.Addr, fpInfo.FpAddr)
+		require.Equal(t, expectedStatus.String(), fpInfo.Status)
+
+		storedFp, err := app.GetFinalityProviderStore().GetFinalityProvider(eotsPk.MustToBTCPK())
+		require.NoError(t, err)
+		require.Equal(t, keyName, storedFp.KeyName)
+		require.Equal(t, fpCfg.BabylonConfig.ChainID, storedFp.ChainID)
+		require.Equal(t, chainFp.Pop.BtcSig, storedFp.Pop.BtcSig)
+		require.Equal(t, votedHeight, storedFp.LastVotedHeight)
+		require.Equal(t, votedHeight, storedFp.LastProcessedHeight)
+
+		// the finality provider cannot be imported twice
+		_, err = app.ImportFinalityProvider(keyName, "", passphrase, eotsPk)
+		require.ErrorIs(t, err, store.ErrDuplicateFinalityProvider)
+	})
+}
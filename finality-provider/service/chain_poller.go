@@ -37,6 +37,20 @@ type skipHeightResponse struct {
 	err error
 }
 
+// blockPoller is the source of the blocks to process by a finality-provider instance,
+// which is either a ChainPoller dedicated to the instance or a subscription to the
+// SharedChainPoller of the instances
+type blockPoller interface {
+	Start(startHeight uint64) error
+	Stop() error
+	IsRunning() bool
+	GetBlockInfoChan() <-chan *types.BlockInfo
+	SkipToHeight(height uint64) error
+	NextHeight() uint64
+}
+
+var _ blockPoller = (*ChainPoller)(nil)
+
 type ChainPoller struct {
 	isStarted *atomic.Bool
 	wg        sync.WaitGroup
@@ -98,11 +112,8 @@ func (cp *ChainPoller) Stop() error {
 		return fmt.Errorf("the chain poller has already stopped")
 	}
 
+	// the client controller is shared with the other pollers and closed by the app
 	cp.logger.Info("stopping the chain poller")
-	err := cp.cc.Close()
-	if err != nil {
-		return err
-	}
 	close(cp.quit)
 	cp.wg.Wait()
 
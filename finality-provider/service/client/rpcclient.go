@@ -8,18 +8,25 @@ import (
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	"github.com/babylonlabs-io/finality-provider/rpcauth"
+	"github.com/babylonlabs-io/finality-provider/util"
 )
 
 type FinalityProviderServiceGRpcClient struct {
 	client proto.FinalityProvidersClient
 }
 
-// NewFinalityProviderServiceGRpcClient creates a new GRPC connection with finality provider daemon.
-func NewFinalityProviderServiceGRpcClient(remoteAddr string) (client *FinalityProviderServiceGRpcClient, cleanUp func() error, err error) {
-	conn, err := grpc.Dial(remoteAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewFinalityProviderServiceGRpcClient creates a new GRPC connection with finality provider daemon,
+// authenticating by the given config, which can be nil if the daemon does not require authentication
+func NewFinalityProviderServiceGRpcClient(remoteAddr string, authCfg *rpcauth.ClientConfig) (client *FinalityProviderServiceGRpcClient, cleanUp func() error, err error) {
+	dialOpts, err := authCfg.DialOptions()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to set up the RPC authentication: %w", err)
+	}
+
+	conn, err := grpc.Dial(util.GRPCDialTarget(remoteAddr), dialOpts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to build gRPC connection to %s: %w", remoteAddr, err)
 	}
@@ -126,6 +133,117 @@ func (c *FinalityProviderServiceGRpcClient) QueryFinalityProviderList(ctx contex
 	return res, nil
 }
 
+// QueryRunningFinalityProviderList - gets the data of the finality providers whose instances are running
+func (c *FinalityProviderServiceGRpcClient) QueryRunningFinalityProviderList(ctx context.Context) (*proto.QueryRunningFinalityProviderListResponse, error) {
+	req := &proto.QueryRunningFinalityProviderListRequest{}
+	res, err := c.client.QueryRunningFinalityProviderList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// StartFinalityProvider - starts the instance of the finality provider
+func (c *FinalityProviderServiceGRpcClient) StartFinalityProvider(ctx context.Context, fpPk, passphrase string) error {
+	req := &proto.StartFinalityProviderRequest{BtcPk: fpPk, Passphrase: passphrase}
+	_, err := c.client.StartFinalityProvider(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StopFinalityProvider - stops the running instance of the finality provider
+func (c *FinalityProviderServiceGRpcClient) StopFinalityProvider(ctx context.Context, fpPk string) error {
+	req := &proto.StopFinalityProviderRequest{BtcPk: fpPk}
+	_, err := c.client.StopFinalityProvider(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PauseFinalityProvider - pauses the finality provider, which submits no finality signatures until it is resumed
+func (c *FinalityProviderServiceGRpcClient) PauseFinalityProvider(ctx context.Context, fpPk string) error {
+	req := &proto.PauseFinalityProviderRequest{BtcPk: fpPk}
+	_, err := c.client.PauseFinalityProvider(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResumeFinalityProvider - resumes the paused finality provider
+func (c *FinalityProviderServiceGRpcClient) ResumeFinalityProvider(ctx context.Context, fpPk, passphrase string) error {
+	req := &proto.ResumeFinalityProviderRequest{BtcPk: fpPk, Passphrase: passphrase}
+	_, err := c.client.ResumeFinalityProvider(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// QueryVotes - gets the votes of the finality provider recorded in the vote journal
+func (c *FinalityProviderServiceGRpcClient) QueryVotes(
+	ctx context.Context, fpPk string, startHeight uint64, limit uint32, reverse bool) (*proto.QueryVotesResponse, error) {
+	req := &proto.QueryVotesRequest{BtcPk: fpPk, StartHeight: startHeight, Limit: limit, Reverse: reverse}
+	res, err := c.client.QueryVotes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RecoverFinalityProviderState - recovers the last voted and processed heights of the finality provider from the chain
+func (c *FinalityProviderServiceGRpcClient) RecoverFinalityProviderState(
+	ctx context.Context, fpPk string) (*proto.RecoverFinalityProviderStateResponse, error) {
+	req := &proto.RecoverFinalityProviderStateRequest{BtcPk: fpPk}
+	res, err := c.client.RecoverFinalityProviderState(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RepairPubRandProofs - regenerates the missing inclusion proofs of the committed public randomness
+func (c *FinalityProviderServiceGRpcClient) RepairPubRandProofs(
+	ctx context.Context, fpPk, passphrase string) (*proto.RepairPubRandProofsResponse, error) {
+	req := &proto.RepairPubRandProofsRequest{BtcPk: fpPk, Passphrase: passphrase}
+	res, err := c.client.RepairPubRandProofs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ImportFinalityProvider - rebuilds the local record of a finality provider registered on the chain
+func (c *FinalityProviderServiceGRpcClient) ImportFinalityProvider(
+	ctx context.Context,
+	eotsPkHex, keyName, chainID, passphrase string,
+) (*proto.ImportFinalityProviderResponse, error) {
+	req := &proto.ImportFinalityProviderRequest{
+		EotsPkHex:  eotsPkHex,
+		KeyName:    keyName,
+		Passphrase: passphrase,
+		ChainId:    chainID,
+	}
+
+	res, err := c.client.ImportFinalityProvider(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // QueryFinalityProviderInfo - gets the finality provider data from local store
 func (c *FinalityProviderServiceGRpcClient) QueryFinalityProviderInfo(ctx context.Context, fpPk *bbntypes.BIP340PubKey) (*proto.QueryFinalityProviderResponse, error) {
 	req := &proto.QueryFinalityProviderRequest{BtcPk: fpPk.MarshalHex()}
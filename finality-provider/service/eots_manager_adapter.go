@@ -4,10 +4,10 @@ import (
 	"fmt"
 
 	bbntypes "github.com/babylonlabs-io/babylon/types"
+	eotstypes "github.com/babylonlabs-io/finality-provider/eotsmanager/types"
 	"github.com/babylonlabs-io/finality-provider/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
-	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -26,29 +26,17 @@ func (fp *FinalityProviderInstance) getPubRandList(startHeight uint64, numPubRan
 	return pubRandList, nil
 }
 
-// TODO: have this function in Babylon side
-func getHashToSignForCommitPubRand(startHeight uint64, numPubRand uint64, commitment []byte) ([]byte, error) {
-	hasher := tmhash.New()
-	if _, err := hasher.Write(sdk.Uint64ToBigEndian(startHeight)); err != nil {
-		return nil, err
-	}
-	if _, err := hasher.Write(sdk.Uint64ToBigEndian(numPubRand)); err != nil {
-		return nil, err
-	}
-	if _, err := hasher.Write(commitment); err != nil {
-		return nil, err
-	}
-	return hasher.Sum(nil), nil
-}
-
 func (fp *FinalityProviderInstance) signPubRandCommit(startHeight uint64, numPubRand uint64, commitment []byte) (*schnorr.Signature, error) {
-	hash, err := getHashToSignForCommitPubRand(startHeight, numPubRand, commitment)
-	if err != nil {
-		return nil, fmt.Errorf("failed to sign the commit public randomness message: %w", err)
+	payload := &eotstypes.SchnorrPayload{
+		PubRandCommit: &eotstypes.PubRandCommit{
+			StartHeight: startHeight,
+			NumPubRand:  numPubRand,
+			Commitment:  commitment,
+		},
 	}
 
 	// sign the message hash using the finality-provider's BTC private key
-	return fp.em.SignSchnorrSig(fp.btcPk.MustMarshal(), hash, fp.passphrase)
+	return fp.em.SignSchnorrSigForPayload(fp.btcPk.MustMarshal(), payload, fp.passphrase)
 }
 
 // TODO: have this function in Babylon side
@@ -56,7 +44,22 @@ func getMsgToSignForVote(blockHeight uint64, blockHash []byte) []byte {
 	return append(sdk.Uint64ToBigEndian(blockHeight), blockHash...)
 }
 
+// guardSignedBlocks records the blocks to be signed in the local db before requesting the
+// signatures, and refuses to sign if a different block has been signed at any of the heights.
+// This protects against double signing even if the EOTS manager has no such protection
+func (fp *FinalityProviderInstance) guardSignedBlocks(blocks []*types.BlockInfo) error {
+	if err := fp.fpState.saveSignedBlocks(blocks); err != nil {
+		return fmt.Errorf("refused to sign the blocks: %w", err)
+	}
+
+	return nil
+}
+
 func (fp *FinalityProviderInstance) signFinalitySig(b *types.BlockInfo) (*bbntypes.SchnorrEOTSSig, error) {
+	if err := fp.guardSignedBlocks([]*types.BlockInfo{b}); err != nil {
+		return nil, err
+	}
+
 	// build proper finality signature request
 	msgToSign := getMsgToSignForVote(b.Height, b.Hash)
 	sig, err := fp.em.SignEOTS(fp.btcPk.MustMarshal(), fp.GetChainID(), msgToSign, b.Height, fp.passphrase)
@@ -66,3 +69,25 @@ func (fp *FinalityProviderInstance) signFinalitySig(b *types.BlockInfo) (*bbntyp
 
 	return bbntypes.NewSchnorrEOTSSigFromModNScalar(sig), nil
 }
+
+// signFinalitySigs signs the given blocks with one request to the EOTS manager
+func (fp *FinalityProviderInstance) signFinalitySigs(blocks []*types.BlockInfo) ([]*btcec.ModNScalar, error) {
+	if err := fp.guardSignedBlocks(blocks); err != nil {
+		return nil, err
+	}
+
+	reqs := make([]*eotstypes.SignRequest, 0, len(blocks))
+	for _, b := range blocks {
+		reqs = append(reqs, &eotstypes.SignRequest{
+			Height: b.Height,
+			Msg:    getMsgToSignForVote(b.Height, b.Hash),
+		})
+	}
+
+	sigs, err := fp.em.SignEOTSBatch(fp.btcPk.MustMarshal(), fp.GetChainID(), reqs, fp.passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign EOTS batch: %w", err)
+	}
+
+	return sigs, nil
+}
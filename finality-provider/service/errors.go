@@ -6,4 +6,5 @@ var (
 	ErrFinalityProviderShutDown = errors.New("the finality provider instance is shutting down")
 	ErrFinalityProviderJailed   = errors.New("the finality provider instance is jailed")
 	ErrFinalityProviderSlashed  = errors.New("the finality provider instance is slashed")
+	ErrFinalityProviderPaused   = errors.New("the finality provider instance is paused")
 )
@@ -23,6 +23,15 @@ func (fp *FinalityProviderInstance) FastSync(startHeight, endHeight uint64) (*Fa
 	}
 	defer fp.inSync.Store(false)
 
+	// a paused finality provider does not vote, so there is nothing to sync
+	if fp.IsPaused() {
+		fp.logger.Debug(
+			"the finality-provider is paused, skip fast sync",
+			zap.String("pk", fp.GetBtcPkHex()),
+		)
+		return nil, nil
+	}
+
 	if startHeight > endHeight {
 		return nil, fmt.Errorf("the start height %v should not be higher than the end height %v",
 			startHeight, endHeight)
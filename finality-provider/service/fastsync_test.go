@@ -27,7 +27,7 @@ func FuzzFastSync_SufficientRandomness(f *testing.F) {
 		currentHeight := finalizedHeight + uint64(r.Int63n(10)+1)
 		mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, currentHeight)
 		mockClientController.EXPECT().QueryLatestFinalizedBlocks(uint64(1)).Return(nil, nil).AnyTimes()
-		_, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight)
+		app, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight)
 		defer cleanUp()
 
 		// commit pub rand
@@ -62,6 +62,63 @@ func FuzzFastSync_SufficientRandomness(f *testing.F) {
 		require.Equal(t, expectedTxHash, result.Responses[0].TxHash)
 		require.Equal(t, currentHeight, fpIns.GetLastVotedHeight())
 		require.Equal(t, currentHeight, fpIns.GetLastProcessedHeight())
+
+		// the votes of the fast sync are recorded in the vote journal
+		votes, nextHeight, err := app.GetVoteJournalStore().QueryVoteRecords(fpIns.GetBtcPk(), 0, 100, false)
+		require.NoError(t, err)
+		require.Zero(t, nextHeight)
+		require.Len(t, votes, len(catchUpBlocks))
+		for i, vote := range votes {
+			require.Equal(t, catchUpBlocks[i].Height, vote.Height)
+			require.Equal(t, expectedTxHash, vote.TxHash)
+			require.True(t, vote.FastSync)
+		}
+	})
+}
+
+// FuzzFastSync_Paused tests a case where the finality provider is paused
+// while lagging behind the current height, it is expected that it neither
+// enters fast-sync when started nor submits any finality signature
+func FuzzFastSync_Paused(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		randomStartingHeight := uint64(r.Int63n(100) + 1)
+		finalizedHeight := randomStartingHeight + uint64(r.Int63n(10)+2)
+		currentHeight := finalizedHeight + uint64(r.Int63n(10)+1)
+		mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, currentHeight)
+		// the poller keeps polling the blocks above the current height
+		mockClientController.EXPECT().QueryBlock(gomock.Any()).
+			DoAndReturn(func(height uint64) (*types.BlockInfo, error) {
+				return &types.BlockInfo{Height: height, Hash: testutil.GenRandomByteArray(r, 32)}, nil
+			}).AnyTimes()
+		finalizedBlock := &types.BlockInfo{Height: finalizedHeight, Hash: testutil.GenRandomByteArray(r, 32)}
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(uint64(1)).Return([]*types.BlockInfo{finalizedBlock}, nil).AnyTimes()
+		// the paused finality provider keeps committing public randomness
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().CommitPubRandList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&types.TxResponse{TxHash: testutil.GenRandomHexStr(r, 32)}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any()).Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderSlashedOrJailed(gomock.Any()).Return(false, false, nil).AnyTimes()
+		// no finality signature is submitted while paused
+		mockClientController.EXPECT().SubmitBatchFinalitySigs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockClientController.EXPECT().SubmitFinalitySig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		_, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight+1)
+		defer cleanUp()
+
+		err := fpIns.SetPaused(true)
+		require.NoError(t, err)
+		err = fpIns.Start()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, fpIns.Stop())
+		}()
+
+		result, err := fpIns.FastSync(finalizedHeight+1, currentHeight)
+		require.NoError(t, err)
+		require.Nil(t, result)
+		require.Zero(t, fpIns.GetLastVotedHeight())
 	})
 }
 
@@ -30,14 +30,19 @@ type FinalityProviderInstance struct {
 
 	fpState      *fpState
 	pubRandState *pubRandState
+	voteJournal  *store.VoteJournalStore
 	cfg          *fpcfg.Config
 
 	logger  *zap.Logger
 	em      eotsmanager.EOTSManager
 	cc      clientcontroller.ClientController
-	poller  *ChainPoller
+	poller  blockPoller
 	metrics *metrics.FpMetrics
 
+	// sharedPoller is the chain poller shared with the other instances, which is
+	// subscribed to on start rather than running a poller for this instance if set
+	sharedPoller *SharedChainPoller
+
 	// passphrase is used to unlock private keys
 	passphrase string
 
@@ -59,6 +64,7 @@ func NewFinalityProviderInstance(
 	cfg *fpcfg.Config,
 	s *store.FinalityProviderStore,
 	prStore *store.PubRandProofStore,
+	voteJournal *store.VoteJournalStore,
 	cc clientcontroller.ClientController,
 	em eotsmanager.EOTSManager,
 	metrics *metrics.FpMetrics,
@@ -79,6 +85,7 @@ func NewFinalityProviderInstance(
 		btcPk:           bbntypes.NewBIP340PubKeyFromBTCPK(sfp.BtcPk),
 		fpState:         NewFpState(sfp, s),
 		pubRandState:    NewPubRandState(prStore),
+		voteJournal:     voteJournal,
 		cfg:             cfg,
 		logger:          logger,
 		isStarted:       atomic.NewBool(false),
@@ -98,6 +105,7 @@ func (fp *FinalityProviderInstance) Start() error {
 	}
 
 	if fp.IsJailed() {
+		fp.isStarted.Store(false)
 		return fmt.Errorf("%w: %s", ErrFinalityProviderJailed, fp.GetBtcPkHex())
 	}
 
@@ -105,15 +113,26 @@ func (fp *FinalityProviderInstance) Start() error {
 
 	startHeight, err := fp.bootstrap()
 	if err != nil {
+		fp.isStarted.Store(false)
 		return fmt.Errorf("failed to bootstrap the finality-provider %s: %w", fp.GetBtcPkHex(), err)
 	}
 
 	fp.logger.Info("the finality-provider has been bootstrapped",
 		zap.String("pk", fp.GetBtcPkHex()), zap.Uint64("height", startHeight))
 
-	poller := NewChainPoller(fp.logger, fp.cfg.PollerConfig, fp.cc, fp.metrics)
+	// the quit channel is created before the poller, which reports its
+	// errors as the critical errors of the instance
+	fp.quit = make(chan struct{})
+
+	var poller blockPoller
+	if fp.sharedPoller != nil {
+		poller = fp.sharedPoller.Subscribe(fp.reportCriticalErr)
+	} else {
+		poller = NewChainPoller(fp.logger, fp.cfg.PollerConfig, fp.cc, fp.metrics)
+	}
 
 	if err := poller.Start(startHeight + 1); err != nil {
+		fp.isStarted.Store(false)
 		return fmt.Errorf("failed to start the poller: %w", err)
 	}
 
@@ -121,8 +140,6 @@ func (fp *FinalityProviderInstance) Start() error {
 
 	fp.laggingTargetChan = make(chan *types.BlockInfo, 1)
 
-	fp.quit = make(chan struct{})
-
 	fp.wg.Add(1)
 	go fp.finalitySigSubmissionLoop()
 	fp.wg.Add(1)
@@ -134,12 +151,25 @@ func (fp *FinalityProviderInstance) Start() error {
 }
 
 func (fp *FinalityProviderInstance) bootstrap() (uint64, error) {
+	// the state is reconciled with the votes on the consumer chain before voting, in case
+	// the local db is lost or restored from a stale backup
+	if err := fp.reconcileStateWithChain(); err != nil {
+		return 0, err
+	}
+
+	// the proofs of the committed public randomness are regenerated if they are missing,
+	// otherwise no vote can be submitted until the next commit
+	if _, err := fp.repairPubRandProofs(); err != nil {
+		return 0, err
+	}
+
 	latestBlock, err := fp.getLatestBlockWithRetry()
 	if err != nil {
 		return 0, err
 	}
 
-	if fp.checkLagging(latestBlock) {
+	// a paused finality provider does not vote, so it does not catch up either
+	if !fp.IsPaused() && fp.checkLagging(latestBlock) {
 		_, err := fp.tryFastSync(latestBlock)
 		if err != nil {
 			if errors.Is(err, ErrFinalityProviderJailed) {
@@ -159,6 +189,43 @@ func (fp *FinalityProviderInstance) bootstrap() (uint64, error) {
 	return startHeight, nil
 }
 
+// reconcileStateWithChain raises the last voted and processed heights to the ones recovered
+// from the votes of the finality provider on the consumer chain, so that the blocks it has
+// voted are not signed again
+func (fp *FinalityProviderInstance) reconcileStateWithChain() error {
+	lastVotedHeight, lastProcessedHeight := fp.GetLastVotedHeight(), fp.GetLastProcessedHeight()
+	state, err := recoverStateFromChain(fp.cc, fp.GetBtcPkBIP340(), lastProcessedHeight, stateRecoveryScanWindow(fp.cfg), fp.logger)
+	if err != nil {
+		return err
+	}
+
+	if state.lastVotedHeight <= lastVotedHeight && state.lastProcessedHeight <= lastProcessedHeight {
+		return nil
+	}
+
+	if err := fp.fpState.setRecoveredHeights(state.lastVotedHeight, state.lastProcessedHeight); err != nil {
+		return fmt.Errorf("failed to set the recovered state: %w", err)
+	}
+
+	fp.logger.Warn(
+		"the local state of the finality provider is behind the consumer chain, recovered from the chain",
+		zap.String("pk", fp.GetBtcPkHex()),
+		zap.Uint64("local_last_voted_height", lastVotedHeight),
+		zap.Uint64("local_last_processed_height", lastProcessedHeight),
+		zap.Uint64("last_voted_height", fp.GetLastVotedHeight()),
+		zap.Uint64("last_processed_height", fp.GetLastProcessedHeight()),
+	)
+
+	return nil
+}
+
+// repairPubRandProofs regenerates the inclusion proofs of the public randomness committed
+// for the heights yet to be voted that are missing from the local db
+func (fp *FinalityProviderInstance) repairPubRandProofs() (*PubRandRepairResult, error) {
+	return repairPubRandProofs(fp.cc, fp.em, fp.pubRandState.s, fp.cfg.PubRandPruning, fp.GetBtcPkBIP340(), fp.GetChainID(),
+		fp.passphrase, fp.GetLastVotedHeight(), fp.logger)
+}
+
 func (fp *FinalityProviderInstance) Stop() error {
 	if !fp.isStarted.Swap(false) {
 		return fmt.Errorf("the finality-provider %s has already stopped", fp.GetBtcPkHex())
@@ -202,6 +269,17 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			if fp.hasProcessed(b) {
 				continue
 			}
+			// a paused finality provider processes the block without voting, so
+			// the block is not voted by the fast sync after being resumed either
+			if fp.IsPaused() {
+				fp.logger.Debug(
+					"the finality-provider is paused, skip voting",
+					zap.String("pk", fp.GetBtcPkHex()),
+					zap.Uint64("height", b.Height),
+				)
+				fp.MustSetLastProcessedHeight(b.Height)
+				continue
+			}
 			// check whether the finality provider has voting power
 			hasVp, err := fp.hasVotingPower(b)
 			if err != nil {
@@ -218,6 +296,12 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			// use the copy of the block to avoid the impact to other receivers
 			nextBlock := *b
 			res, err := fp.retrySubmitFinalitySignatureUntilBlockFinalized(&nextBlock)
+			if errors.Is(err, ErrFinalityProviderPaused) {
+				// the finality provider is paused while retrying, so the block
+				// is processed without voting as if it was paused before
+				fp.MustSetLastProcessedHeight(b.Height)
+				continue
+			}
 			if err != nil {
 				fp.metrics.IncrementFpTotalFailedVotes(fp.GetBtcPkHex())
 				if !errors.Is(err, ErrFinalityProviderShutDown) {
@@ -239,6 +323,10 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			)
 
 		case targetBlock := <-fp.laggingTargetChan:
+			if fp.IsPaused() {
+				fp.isLagging.Store(false)
+				continue
+			}
 			res, err := fp.tryFastSync(targetBlock)
 			fp.isLagging.Store(false)
 			if err != nil {
@@ -428,9 +516,14 @@ func (fp *FinalityProviderInstance) hasVotingPower(b *types.BlockInfo) (bool, er
 }
 
 func (fp *FinalityProviderInstance) reportCriticalErr(err error) {
-	fp.criticalErrChan <- &CriticalError{
+	// the error is dropped if the instance is stopping, e.g., by the manager
+	// handling a critical error reported earlier
+	select {
+	case fp.criticalErrChan <- &CriticalError{
 		err:     err,
 		fpBtcPk: fp.GetBtcPkBIP340(),
+	}:
+	case <-fp.quit:
 	}
 }
 
@@ -440,13 +533,24 @@ func (fp *FinalityProviderInstance) checkLagging(currentBlock *types.BlockInfo)
 }
 
 // retrySubmitFinalitySignatureUntilBlockFinalized periodically tries to submit finality signature until success or the block is finalized
-// error will be returned if maximum retries have been reached or the query to the consumer chain fails
+// error will be returned if maximum retries have been reached or the query to the consumer chain fails,
+// and ErrFinalityProviderPaused will be returned if the finality provider is paused
 func (fp *FinalityProviderInstance) retrySubmitFinalitySignatureUntilBlockFinalized(targetBlock *types.BlockInfo) (*types.TxResponse, error) {
 	var failedCycles uint32
 
 	// we break the for loop if the block is finalized or the signature is successfully submitted
 	// error will be returned if maximum retries have been reached or the query to the consumer chain fails
 	for {
+		// the retries stop once the finality provider is paused
+		if fp.IsPaused() {
+			fp.logger.Debug(
+				"the finality-provider is paused, stop submitting finality signature",
+				zap.String("pk", fp.GetBtcPkHex()),
+				zap.Uint64("target_block_height", targetBlock.Height),
+			)
+			return nil, ErrFinalityProviderPaused
+		}
+
 		// error will be returned if max retries have been reached
 		res, err := fp.SubmitFinalitySignature(targetBlock)
 		if err != nil {
@@ -463,6 +567,11 @@ func (fp *FinalityProviderInstance) retrySubmitFinalitySignatureUntilBlockFinali
 				return nil, err
 			}
 
+			// signing a conflicting block is refused however many times it is retried
+			if errors.Is(err, store.ErrConflictingSignedBlock) {
+				return nil, err
+			}
+
 			if clientcontroller.IsExpected(err) {
 				return nil, nil
 			}
@@ -611,7 +720,7 @@ func (fp *FinalityProviderInstance) CommitPubRand(tipHeight uint64) (*types.TxRe
 	commitment, proofList := types.GetPubRandCommitAndProofs(pubRandList)
 
 	// store them to database
-	if err := fp.pubRandState.AddPubRandProofList(pubRandList, proofList); err != nil {
+	if err := fp.pubRandState.AddPubRandProofList(fp.GetBtcPk(), fp.GetChainID(), startHeight, pubRandList, proofList); err != nil {
 		return nil, fmt.Errorf("failed to save public randomness to DB: %w", err)
 	}
 
@@ -649,7 +758,7 @@ func (fp *FinalityProviderInstance) SubmitFinalitySignature(b *types.BlockInfo)
 	pubRand := prList[0]
 
 	// get inclusion proof
-	proofBytes, err := fp.pubRandState.GetPubRandProof(pubRand)
+	proofBytes, err := fp.pubRandState.GetPubRandProof(fp.GetBtcPk(), fp.GetChainID(), b.Height, pubRand)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"failed to get inclusion proof of public randomness %s for FP %s for block %d: %w",
@@ -668,6 +777,7 @@ func (fp *FinalityProviderInstance) SubmitFinalitySignature(b *types.BlockInfo)
 
 	// update DB
 	fp.MustUpdateStateAfterFinalitySigSubmission(b.Height)
+	fp.recordVotes([]*types.BlockInfo{b}, res, false)
 
 	// update metrics
 	fp.metrics.RecordFpVoteTime(fp.GetBtcPkHex())
@@ -695,19 +805,15 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	}
 	// get proof list
 	// TODO: how to recover upon having an error in GetPubRandProofList?
-	proofBytesList, err := fp.pubRandState.GetPubRandProofList(prList)
+	proofBytesList, err := fp.pubRandState.GetPubRandProofList(fp.GetBtcPk(), fp.GetChainID(), blocks[0].Height, prList)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get public randomness inclusion proof list: %v", err)
 	}
 
 	// sign blocks
-	sigList := make([]*btcec.ModNScalar, 0, len(blocks))
-	for _, b := range blocks {
-		eotsSig, err := fp.signFinalitySig(b)
-		if err != nil {
-			return nil, err
-		}
-		sigList = append(sigList, eotsSig.ToModNScalar())
+	sigList, err := fp.signFinalitySigs(blocks)
+	if err != nil {
+		return nil, err
 	}
 
 	// send finality signature to the consumer chain
@@ -725,10 +831,42 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	// update DB
 	highBlock := blocks[len(blocks)-1]
 	fp.MustUpdateStateAfterFinalitySigSubmission(highBlock.Height)
+	// the batch submission only happens in the fast sync
+	fp.recordVotes(blocks, res, true)
 
 	return res, nil
 }
 
+// recordVotes records the votes over the blocks submitted in the transaction in the vote
+// journal. Failing to record the votes does not fail the submission, which is on chain already
+func (fp *FinalityProviderInstance) recordVotes(blocks []*types.BlockInfo, res *types.TxResponse, fastSync bool) {
+	var txHash string
+	if res != nil {
+		txHash = res.TxHash
+	}
+	now := time.Now().Unix()
+	records := make([]*proto.VoteRecord, 0, len(blocks))
+	for _, b := range blocks {
+		records = append(records, &proto.VoteRecord{
+			Height:    b.Height,
+			BlockHash: b.Hash,
+			TxHash:    txHash,
+			Timestamp: now,
+			FastSync:  fastSync,
+		})
+	}
+
+	if err := fp.voteJournal.AddVoteRecords(fp.GetBtcPk(), records); err != nil {
+		fp.logger.Error(
+			"failed to record the votes in the vote journal",
+			zap.String("pk", fp.GetBtcPkHex()),
+			zap.Uint64("start_height", blocks[0].Height),
+			zap.Int("num_blocks", len(blocks)),
+			zap.Error(err),
+		)
+	}
+}
+
 // TestSubmitFinalitySignatureAndExtractPrivKey is exposed for presentation/testing purpose to allow manual sending finality signature
 // this API is the same as SubmitFinalitySignature except that we don't constraint the voting height and update status
 // Note: this should not be used in the submission loop
@@ -741,7 +879,7 @@ func (fp *FinalityProviderInstance) TestSubmitFinalitySignatureAndExtractPrivKey
 	pubRand := prList[0]
 
 	// get proof
-	proofBytes, err := fp.pubRandState.GetPubRandProof(pubRand)
+	proofBytes, err := fp.pubRandState.GetPubRandProof(fp.GetBtcPk(), fp.GetChainID(), b.Height, pubRand)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get public randomness inclusion proof: %v", err)
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
+	fpstore "github.com/babylonlabs-io/finality-provider/finality-provider/store"
 	"github.com/babylonlabs-io/finality-provider/metrics"
 	"github.com/babylonlabs-io/finality-provider/testutil"
 	"github.com/babylonlabs-io/finality-provider/types"
@@ -59,7 +60,7 @@ func FuzzSubmitFinalitySig(f *testing.F) {
 		startingBlock := &types.BlockInfo{Height: randomStartingHeight, Hash: testutil.GenRandomByteArray(r, 32)}
 		mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, currentHeight)
 		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
-		_, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight)
+		app, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight)
 		defer cleanUp()
 
 		// commit pub rand
@@ -96,6 +97,27 @@ func FuzzSubmitFinalitySig(f *testing.F) {
 		// check the last_voted_height
 		require.Equal(t, nextBlock.Height, fpIns.GetLastVotedHeight())
 		require.Equal(t, nextBlock.Height, fpIns.GetLastProcessedHeight())
+
+		// check the vote recorded in the vote journal
+		vote, err := app.GetVoteJournalStore().GetVoteRecord(fpIns.GetBtcPk(), nextBlock.Height)
+		require.NoError(t, err)
+		require.Equal(t, nextBlock.Hash, vote.BlockHash)
+		require.Equal(t, expectedTxHash, vote.TxHash)
+		require.False(t, vote.FastSync)
+
+		// signing a conflicting block at the voted height is refused by every signing path
+		// without reaching the EOTS manager or the consumer chain
+		conflictingBlock := &types.BlockInfo{
+			Height: nextBlock.Height,
+			Hash:   testutil.GenRandomByteArray(r, 32),
+		}
+		_, err = fpIns.SubmitFinalitySignature(conflictingBlock)
+		require.ErrorIs(t, err, fpstore.ErrConflictingSignedBlock)
+		_, _, err = fpIns.TestSubmitFinalitySignatureAndExtractPrivKey(conflictingBlock)
+		require.ErrorIs(t, err, fpstore.ErrConflictingSignedBlock)
+		hash, err := app.GetFinalityProviderStore().GetSignedBlockHash(fpIns.GetBtcPk(), nextBlock.Height)
+		require.NoError(t, err)
+		require.Equal(t, nextBlock.Hash, hash)
 	})
 }
 
@@ -130,7 +152,7 @@ func startFinalityProviderAppWithRegisteredFp(t *testing.T, r *rand.Rand, cc cli
 	require.NoError(t, err)
 	// TODO: use mock metrics
 	m := metrics.NewFpMetrics()
-	fpIns, err := service.NewFinalityProviderInstance(fp.GetBIP340BTCPK(), &fpCfg, fpStore, pubRandProofStore, cc, em, m, passphrase, make(chan *service.CriticalError), logger)
+	fpIns, err := service.NewFinalityProviderInstance(fp.GetBIP340BTCPK(), &fpCfg, fpStore, pubRandProofStore, app.GetVoteJournalStore(), cc, em, m, passphrase, make(chan *service.CriticalError), logger)
 	require.NoError(t, err)
 
 	cleanUp := func() {
@@ -3,6 +3,7 @@ package service
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -30,19 +31,30 @@ func (ce *CriticalError) Error() string {
 	return fmt.Sprintf("critical err on finality-provider %s: %s", ce.fpBtcPk.MarshalHex(), ce.err.Error())
 }
 
-// FinalityProviderManager is responsible to initiate and start the given finality
-// provider instance, monitor its running status
+// FinalityProviderManager is responsible to initiate and start the finality provider
+// instances, each of which is started and stopped independently, and monitor their
+// running status
 type FinalityProviderManager struct {
 	startOnce sync.Once
 	stopOnce  sync.Once
 
 	wg sync.WaitGroup
 
-	fpIns *FinalityProviderInstance
+	mu sync.Mutex
+	// mapping between the BTC public key hex and the finality-provider instance
+	fpis map[string]*FinalityProviderInstance
+	// the finality providers stopped through StopFinalityProvider or due to critical
+	// errors, which are not started by the status sync until they are started explicitly
+	stoppedFps map[string]struct{}
+
+	// sharedPoller is the chain poller shared by the instances, nil if each
+	// instance polls the chain on its own
+	sharedPoller *SharedChainPoller
 
 	// needed for initiating finality-provider instances
 	fps          *store.FinalityProviderStore
 	pubRandStore *store.PubRandProofStore
+	voteJournal  *store.VoteJournalStore
 	config       *fpcfg.Config
 	cc           clientcontroller.ClientController
 	em           eotsmanager.EOTSManager
@@ -58,16 +70,26 @@ type FinalityProviderManager struct {
 func NewFinalityProviderManager(
 	fps *store.FinalityProviderStore,
 	pubRandStore *store.PubRandProofStore,
+	voteJournal *store.VoteJournalStore,
 	config *fpcfg.Config,
 	cc clientcontroller.ClientController,
 	em eotsmanager.EOTSManager,
 	metrics *metrics.FpMetrics,
 	logger *zap.Logger,
 ) (*FinalityProviderManager, error) {
+	var sharedPoller *SharedChainPoller
+	if config.PollerConfig.Shared {
+		sharedPoller = NewSharedChainPoller(logger, config.PollerConfig, cc, metrics)
+	}
+
 	return &FinalityProviderManager{
+		fpis:            make(map[string]*FinalityProviderInstance),
+		stoppedFps:      make(map[string]struct{}),
+		sharedPoller:    sharedPoller,
 		criticalErrChan: make(chan *CriticalError),
 		fps:             fps,
 		pubRandStore:    pubRandStore,
+		voteJournal:     voteJournal,
 		config:          config,
 		cc:              cc,
 		em:              em,
@@ -78,9 +100,9 @@ func NewFinalityProviderManager(
 }
 
 // monitorCriticalErr takes actions when it receives critical errors from a finality-provider instance
-// if the finality-provider is slashed, it will be terminated and the program keeps running in case
-// new finality providers join
-// otherwise, the program will panic
+// if the finality-provider is slashed or jailed, it will be terminated and the program keeps running
+// otherwise, only the failing instance is terminated so that the other instances keep running, and
+// it is not started again until it is started explicitly
 func (fpm *FinalityProviderManager) monitorCriticalErr() {
 	defer fpm.wg.Done()
 
@@ -89,7 +111,7 @@ func (fpm *FinalityProviderManager) monitorCriticalErr() {
 	for {
 		select {
 		case criticalErr = <-fpm.criticalErrChan:
-			fpi, err := fpm.GetFinalityProviderInstance()
+			fpi, err := fpm.GetFinalityProviderInstance(criticalErr.fpBtcPk)
 			if err != nil {
 				fpm.logger.Debug("the finality-provider instance is already shutdown",
 					zap.String("pk", criticalErr.fpBtcPk.MarshalHex()))
@@ -110,8 +132,12 @@ func (fpm *FinalityProviderManager) monitorCriticalErr() {
 
 				continue
 			}
-			fpm.logger.Fatal(instanceTerminatingMsg,
+			fpm.logger.Error(instanceTerminatingMsg,
 				zap.String("pk", criticalErr.fpBtcPk.MarshalHex()), zap.Error(criticalErr.err))
+			if err := fpm.StopFinalityProvider(criticalErr.fpBtcPk); err != nil {
+				fpm.logger.Error("failed to terminate the finality-provider instance",
+					zap.String("pk", criticalErr.fpBtcPk.MarshalHex()), zap.Error(err))
+			}
 		case <-fpm.quit:
 			return
 		}
@@ -139,8 +165,8 @@ func (fpm *FinalityProviderManager) monitorStatusUpdate() {
 	for {
 		select {
 		case <-statusUpdateTicker.C:
-			fpi := fpm.fpIns
-			if fpi == nil {
+			fpis := fpm.ListFinalityProviderInstances()
+			if len(fpis) == 0 {
 				continue
 			}
 
@@ -149,67 +175,8 @@ func (fpm *FinalityProviderManager) monitorStatusUpdate() {
 				fpm.logger.Debug("failed to get the latest block", zap.Error(err))
 				continue
 			}
-			oldStatus := fpi.GetStatus()
-			power, err := fpi.GetVotingPowerWithRetry(latestBlock.Height)
-			if err != nil {
-				fpm.logger.Debug(
-					"failed to get the voting power",
-					zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
-					zap.Uint64("height", latestBlock.Height),
-					zap.Error(err),
-				)
-				continue
-			}
-			// power > 0 (slashed_height must > 0), set status to ACTIVE
-			if power > 0 {
-				if oldStatus != proto.FinalityProviderStatus_ACTIVE {
-					fpi.MustSetStatus(proto.FinalityProviderStatus_ACTIVE)
-					fpm.logger.Debug(
-						"the finality-provider status is changed to ACTIVE",
-						zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
-						zap.String("old_status", oldStatus.String()),
-						zap.Uint64("power", power),
-					)
-				}
-				continue
-			}
-			slashed, jailed, err := fpi.GetFinalityProviderSlashedOrJailedWithRetry()
-			if err != nil {
-				fpm.logger.Debug(
-					"failed to get the slashed or jailed status",
-					zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
-					zap.Error(err),
-				)
-				continue
-			}
-			// power == 0 and slashed == true, set status to SLASHED, stop, and remove the finality-provider instance
-			if slashed {
-				fpm.setFinalityProviderSlashed(fpi)
-				fpm.logger.Warn(
-					"the finality-provider is slashed",
-					zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
-					zap.String("old_status", oldStatus.String()),
-				)
-				continue
-			}
-			// power == 0 and jailed == true, set status to JAILED, stop, and remove the finality-provider instance
-			if jailed {
-				fpm.setFinalityProviderJailed(fpi)
-				fpm.logger.Warn(
-					"the finality-provider is jailed",
-					zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
-					zap.String("old_status", oldStatus.String()),
-				)
-				continue
-			}
-			// power == 0 and slashed_height == 0, change to INACTIVE if the current status is ACTIVE
-			if oldStatus == proto.FinalityProviderStatus_ACTIVE {
-				fpi.MustSetStatus(proto.FinalityProviderStatus_INACTIVE)
-				fpm.logger.Debug(
-					"the finality-provider status is changed to INACTIVE",
-					zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
-					zap.String("old_status", oldStatus.String()),
-				)
+			for _, fpi := range fpis {
+				fpm.updateStatus(fpi, latestBlock)
 			}
 		case <-fpm.quit:
 			return
@@ -217,20 +184,89 @@ func (fpm *FinalityProviderManager) monitorStatusUpdate() {
 	}
 }
 
+// updateStatus updates the status of the finality-provider instance from its voting power
+// and slashed or jailed status at the latest block
+func (fpm *FinalityProviderManager) updateStatus(fpi *FinalityProviderInstance, latestBlock *types.BlockInfo) {
+	oldStatus := fpi.GetStatus()
+	power, err := fpi.GetVotingPowerWithRetry(latestBlock.Height)
+	if err != nil {
+		fpm.logger.Debug(
+			"failed to get the voting power",
+			zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
+			zap.Uint64("height", latestBlock.Height),
+			zap.Error(err),
+		)
+		return
+	}
+	// power > 0 (slashed_height must > 0), set status to ACTIVE
+	if power > 0 {
+		if oldStatus != proto.FinalityProviderStatus_ACTIVE {
+			fpi.MustSetStatus(proto.FinalityProviderStatus_ACTIVE)
+			fpm.logger.Debug(
+				"the finality-provider status is changed to ACTIVE",
+				zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
+				zap.String("old_status", oldStatus.String()),
+				zap.Uint64("power", power),
+			)
+		}
+		return
+	}
+	slashed, jailed, err := fpi.GetFinalityProviderSlashedOrJailedWithRetry()
+	if err != nil {
+		fpm.logger.Debug(
+			"failed to get the slashed or jailed status",
+			zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
+			zap.Error(err),
+		)
+		return
+	}
+	// power == 0 and slashed == true, set status to SLASHED, stop, and remove the finality-provider instance
+	if slashed {
+		fpm.setFinalityProviderSlashed(fpi)
+		fpm.logger.Warn(
+			"the finality-provider is slashed",
+			zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
+			zap.String("old_status", oldStatus.String()),
+		)
+		return
+	}
+	// power == 0 and jailed == true, set status to JAILED, stop, and remove the finality-provider instance
+	if jailed {
+		fpm.setFinalityProviderJailed(fpi)
+		fpm.logger.Warn(
+			"the finality-provider is jailed",
+			zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
+			zap.String("old_status", oldStatus.String()),
+		)
+		return
+	}
+	// power == 0 and slashed_height == 0, change to INACTIVE if the current status is ACTIVE
+	if oldStatus == proto.FinalityProviderStatus_ACTIVE {
+		fpi.MustSetStatus(proto.FinalityProviderStatus_INACTIVE)
+		fpm.logger.Debug(
+			"the finality-provider status is changed to INACTIVE",
+			zap.String("fp_btc_pk", fpi.GetBtcPkHex()),
+			zap.String("old_status", oldStatus.String()),
+		)
+	}
+}
+
 func (fpm *FinalityProviderManager) setFinalityProviderSlashed(fpi *FinalityProviderInstance) {
 	fpi.MustSetStatus(proto.FinalityProviderStatus_SLASHED)
-	if err := fpm.removeFinalityProviderInstance(); err != nil {
+	if err := fpm.removeFinalityProviderInstance(fpi.GetBtcPkBIP340()); err != nil {
 		panic(fmt.Errorf("failed to terminate a slashed finality-provider %s: %w", fpi.GetBtcPkHex(), err))
 	}
 }
 
 func (fpm *FinalityProviderManager) setFinalityProviderJailed(fpi *FinalityProviderInstance) {
 	fpi.MustSetStatus(proto.FinalityProviderStatus_JAILED)
-	if err := fpm.removeFinalityProviderInstance(); err != nil {
+	if err := fpm.removeFinalityProviderInstance(fpi.GetBtcPkBIP340()); err != nil {
 		panic(fmt.Errorf("failed to terminate a jailed finality-provider %s: %w", fpi.GetBtcPkHex(), err))
 	}
 }
 
+// StartFinalityProvider starts the instance of the finality provider, while the instances of
+// the other finality providers keep running
 func (fpm *FinalityProviderManager) StartFinalityProvider(fpPk *bbntypes.BIP340PubKey, passphrase string) error {
 	fpm.startOnce.Do(func() {
 		fpm.wg.Add(2)
@@ -249,40 +285,176 @@ func (fpm *FinalityProviderManager) StartFinalityProvider(fpPk *bbntypes.BIP340P
 	return nil
 }
 
+// StopFinalityProvider stops and removes the instance of the finality provider, which is not
+// started by the status sync until it is started again through StartFinalityProvider
+func (fpm *FinalityProviderManager) StopFinalityProvider(fpPk *bbntypes.BIP340PubKey) error {
+	pkHex := fpPk.MarshalHex()
+	if _, err := fpm.GetFinalityProviderInstance(fpPk); err != nil {
+		return err
+	}
+
+	fpm.logger.Info("stopping finality provider", zap.String("pk", pkHex))
+
+	fpm.mu.Lock()
+	fpm.stoppedFps[pkHex] = struct{}{}
+	fpm.mu.Unlock()
+
+	if err := fpm.removeFinalityProviderInstance(fpPk); err != nil {
+		return err
+	}
+
+	fpm.logger.Info("finality provider is stopped", zap.String("pk", pkHex))
+
+	return nil
+}
+
+// PauseFinalityProvider pauses the finality provider, whose instance keeps committing public
+// randomness but submits no finality signatures. The state is stored so that the paused
+// finality provider is not started by the status sync after the daemon restarts
+func (fpm *FinalityProviderManager) PauseFinalityProvider(fpPk *bbntypes.BIP340PubKey) error {
+	if err := fpm.setFinalityProviderPaused(fpPk, true); err != nil {
+		return err
+	}
+
+	fpm.logger.Info("finality provider is paused", zap.String("pk", fpPk.MarshalHex()))
+
+	return nil
+}
+
+// ResumeFinalityProvider resumes the paused finality provider, and starts its instance if it
+// is not running
+func (fpm *FinalityProviderManager) ResumeFinalityProvider(fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	if err := fpm.setFinalityProviderPaused(fpPk, false); err != nil {
+		return err
+	}
+
+	fpm.logger.Info("finality provider is resumed", zap.String("pk", fpPk.MarshalHex()))
+
+	if fpm.IsFinalityProviderRunning(fpPk) {
+		return nil
+	}
+
+	return fpm.StartFinalityProvider(fpPk, passphrase)
+}
+
+// setFinalityProviderPaused sets the paused state of the finality provider through its
+// instance if there is one so that the instance sees the change, or in the store otherwise
+func (fpm *FinalityProviderManager) setFinalityProviderPaused(fpPk *bbntypes.BIP340PubKey, paused bool) error {
+	if fpi, err := fpm.GetFinalityProviderInstance(fpPk); err == nil {
+		return fpi.SetPaused(paused)
+	}
+
+	return fpm.fps.SetFpPaused(fpPk.MustToBTCPK(), paused)
+}
+
+// RecoverFinalityProviderState raises the last voted and processed heights of the finality
+// provider to the ones recovered from its votes on the consumer chain, through its instance
+// if there is one, and returns the stored finality provider after the recovery
+func (fpm *FinalityProviderManager) RecoverFinalityProviderState(fpPk *bbntypes.BIP340PubKey) (*store.StoredFinalityProvider, error) {
+	if fpi, err := fpm.GetFinalityProviderInstance(fpPk); err == nil {
+		if err := fpi.reconcileStateWithChain(); err != nil {
+			return nil, err
+		}
+
+		return fpi.GetStoreFinalityProvider(), nil
+	}
+
+	btcPk := fpPk.MustToBTCPK()
+	storedFp, err := fpm.fps.GetFinalityProvider(btcPk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finality provider from db: %w", err)
+	}
+
+	state, err := recoverStateFromChain(fpm.cc, fpPk, storedFp.LastProcessedHeight, stateRecoveryScanWindow(fpm.config), fpm.logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := fpm.fps.SetFpLastVotedHeight(btcPk, state.lastVotedHeight); err != nil {
+		return nil, err
+	}
+	if err := fpm.fps.SetFpLastProcessedHeight(btcPk, state.lastProcessedHeight); err != nil {
+		return nil, err
+	}
+
+	return fpm.fps.GetFinalityProvider(btcPk)
+}
+
+// RepairPubRandProofs regenerates the inclusion proofs of the public randomness committed by
+// the finality provider that are missing from the local db, through its instance if there is
+// one. Otherwise, the EOTS key is unlocked with the given passphrase
+func (fpm *FinalityProviderManager) RepairPubRandProofs(fpPk *bbntypes.BIP340PubKey, passphrase string) (*PubRandRepairResult, error) {
+	if fpi, err := fpm.GetFinalityProviderInstance(fpPk); err == nil {
+		return fpi.repairPubRandProofs()
+	}
+
+	storedFp, err := fpm.fps.GetFinalityProvider(fpPk.MustToBTCPK())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finality provider from db: %w", err)
+	}
+
+	return repairPubRandProofs(fpm.cc, fpm.em, fpm.pubRandStore, fpm.config.PubRandPruning, fpPk, []byte(storedFp.ChainID),
+		passphrase, storedFp.LastVotedHeight, fpm.logger)
+}
+
 func (fpm *FinalityProviderManager) Stop() error {
 	var stopErr error
 	fpm.stopOnce.Do(func() {
 		close(fpm.quit)
 		fpm.wg.Wait()
 
-		if fpm.fpIns == nil {
-			return
-		}
+		for _, fpi := range fpm.ListFinalityProviderInstances() {
+			if !fpi.IsRunning() {
+				continue
+			}
 
-		if !fpm.fpIns.IsRunning() {
-			return
-		}
+			pkHex := fpi.GetBtcPkHex()
+			fpm.logger.Info("stopping finality provider", zap.String("pk", pkHex))
 
-		pkHex := fpm.fpIns.GetBtcPkHex()
-		fpm.logger.Info("stopping finality provider", zap.String("pk", pkHex))
+			if err := fpi.Stop(); err != nil {
+				stopErr = errors.Join(stopErr, err)
+				continue
+			}
 
-		if err := fpm.fpIns.Stop(); err != nil {
-			stopErr = err
-			return
+			fpm.logger.Info("finality provider is stopped", zap.String("pk", pkHex))
 		}
 
-		fpm.logger.Info("finality provider is stopped", zap.String("pk", pkHex))
+		if fpm.sharedPoller != nil {
+			fpm.sharedPoller.Stop()
+		}
 	})
 
 	return stopErr
 }
 
-func (fpm *FinalityProviderManager) GetFinalityProviderInstance() (*FinalityProviderInstance, error) {
-	if fpm.fpIns == nil {
-		return nil, fmt.Errorf("finality provider does not exist")
+// GetFinalityProviderInstance returns the instance of the finality provider, which is
+// either running or stopped by itself, e.g., for being slashed
+func (fpm *FinalityProviderManager) GetFinalityProviderInstance(fpPk *bbntypes.BIP340PubKey) (*FinalityProviderInstance, error) {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	fpi, ok := fpm.fpis[fpPk.MarshalHex()]
+	if !ok {
+		return nil, fmt.Errorf("finality provider %s does not exist", fpPk.MarshalHex())
 	}
 
-	return fpm.fpIns, nil
+	return fpi, nil
+}
+
+// ListFinalityProviderInstances returns the instances of the finality providers sorted by
+// their BTC public keys
+func (fpm *FinalityProviderManager) ListFinalityProviderInstances() []*FinalityProviderInstance {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	fpis := make([]*FinalityProviderInstance, 0, len(fpm.fpis))
+	for _, fpi := range fpm.fpis {
+		fpis = append(fpis, fpi)
+	}
+	sort.Slice(fpis, func(i, j int) bool {
+		return fpis[i].GetBtcPkHex() < fpis[j].GetBtcPkHex()
+	})
+
+	return fpis
 }
 
 func (fpm *FinalityProviderManager) AllFinalityProviders() ([]*proto.FinalityProviderInfo, error) {
@@ -305,6 +477,25 @@ func (fpm *FinalityProviderManager) AllFinalityProviders() ([]*proto.FinalityPro
 	return fpsInfo, nil
 }
 
+// RunningFinalityProviders returns the information of the finality providers whose
+// instances are running
+func (fpm *FinalityProviderManager) RunningFinalityProviders() ([]*proto.FinalityProviderInfo, error) {
+	var fpsInfo []*proto.FinalityProviderInfo
+	for _, fpi := range fpm.ListFinalityProviderInstances() {
+		if !fpi.IsRunning() {
+			continue
+		}
+
+		fpInfo, err := fpm.FinalityProviderInfo(fpi.GetBtcPkBIP340())
+		if err != nil {
+			return nil, err
+		}
+		fpsInfo = append(fpsInfo, fpInfo)
+	}
+
+	return fpsInfo, nil
+}
+
 func (fpm *FinalityProviderManager) FinalityProviderInfo(fpPk *bbntypes.BIP340PubKey) (*proto.FinalityProviderInfo, error) {
 	storedFp, err := fpm.fps.GetFinalityProvider(fpPk.MustToBTCPK())
 	if err != nil {
@@ -321,21 +512,29 @@ func (fpm *FinalityProviderManager) FinalityProviderInfo(fpPk *bbntypes.BIP340Pu
 }
 
 func (fpm *FinalityProviderManager) IsFinalityProviderRunning(fpPk *bbntypes.BIP340PubKey) bool {
-	if fpm.fpIns == nil {
+	fpi, err := fpm.GetFinalityProviderInstance(fpPk)
+	if err != nil {
 		return false
 	}
 
-	if fpm.fpIns.GetBtcPkHex() != fpPk.MarshalHex() {
-		return false
-	}
+	return fpi.IsRunning()
+}
+
+// isFinalityProviderStopped returns whether the finality provider has been stopped through
+// StopFinalityProvider or due to a critical error and not started again since then
+func (fpm *FinalityProviderManager) isFinalityProviderStopped(fpPk *bbntypes.BIP340PubKey) bool {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
 
-	return fpm.fpIns.IsRunning()
+	_, ok := fpm.stoppedFps[fpPk.MarshalHex()]
+
+	return ok
 }
 
-func (fpm *FinalityProviderManager) removeFinalityProviderInstance() error {
-	fpi := fpm.fpIns
-	if fpi == nil {
-		return fmt.Errorf("the finality provider instance does not exist")
+func (fpm *FinalityProviderManager) removeFinalityProviderInstance(fpPk *bbntypes.BIP340PubKey) error {
+	fpi, err := fpm.GetFinalityProviderInstance(fpPk)
+	if err != nil {
+		return err
 	}
 	if fpi.IsRunning() {
 		if err := fpi.Stop(); err != nil {
@@ -343,7 +542,9 @@ func (fpm *FinalityProviderManager) removeFinalityProviderInstance() error {
 		}
 	}
 
-	fpm.fpIns = nil
+	fpm.mu.Lock()
+	delete(fpm.fpis, fpPk.MarshalHex())
+	fpm.mu.Unlock()
 
 	return nil
 }
@@ -354,19 +555,28 @@ func (fpm *FinalityProviderManager) startFinalityProviderInstance(
 	passphrase string,
 ) error {
 	pkHex := pk.MarshalHex()
-	if fpm.fpIns == nil {
-		fpIns, err := NewFinalityProviderInstance(
-			pk, fpm.config, fpm.fps, fpm.pubRandStore, fpm.cc, fpm.em,
+
+	fpm.mu.Lock()
+	fpIns, ok := fpm.fpis[pkHex]
+	if !ok {
+		var err error
+		fpIns, err = NewFinalityProviderInstance(
+			pk, fpm.config, fpm.fps, fpm.pubRandStore, fpm.voteJournal, fpm.cc, fpm.em,
 			fpm.metrics, passphrase, fpm.criticalErrChan, fpm.logger,
 		)
 		if err != nil {
+			fpm.mu.Unlock()
 			return fmt.Errorf("failed to create finality provider instance %s: %w", pkHex, err)
 		}
+		fpIns.sharedPoller = fpm.sharedPoller
 
-		fpm.fpIns = fpIns
+		fpm.fpis[pkHex] = fpIns
 	}
+	delete(fpm.stoppedFps, pkHex)
+	fpm.mu.Unlock()
 
-	return fpm.fpIns.Start()
+	// the instance is started without holding the lock as bootstrapping it may take a while
+	return fpIns.Start()
 }
 
 func (fpm *FinalityProviderManager) getLatestBlockWithRetry() (*types.BlockInfo, error) {
@@ -57,6 +57,9 @@ func FuzzStatusUpdate(f *testing.F) {
 		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
 		mockClientController.EXPECT().QueryBlock(gomock.Any()).Return(currentBlockRes, nil).AnyTimes()
 		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), uint64(1)).Return(nil, nil).AnyTimes()
+		// no public randomness is committed, so there is no proof to repair at startup
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().QueryVotesAtHeight(gomock.Any()).Return(nil, nil).AnyTimes()
 
 		votingPower := uint64(r.Intn(2))
 		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), currentHeight).Return(votingPower, nil).AnyTimes()
@@ -76,7 +79,7 @@ func FuzzStatusUpdate(f *testing.F) {
 
 		err := vm.StartFinalityProvider(fpPk, passphrase)
 		require.NoError(t, err)
-		fpIns, err := vm.GetFinalityProviderInstance()
+		fpIns, err := vm.GetFinalityProviderInstance(fpPk)
 		require.NoError(t, err)
 		// stop the finality-provider as we are testing static functionalities
 		err = fpIns.Stop()
@@ -103,7 +106,135 @@ func waitForStatus(t *testing.T, fpIns *service.FinalityProviderInstance, s prot
 		}, eventuallyWaitTimeOut, eventuallyPollTime)
 }
 
+// TestMultipleFinalityProviders tests that the finality-provider instances hosted by the
+// same manager are started and stopped independently, polling the chain either on their
+// own or through the shared poller
+func TestMultipleFinalityProviders(t *testing.T) {
+	for _, shared := range []bool{false, true} {
+		r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		vm, fpPks, cleanUp := newFinalityProviderManagerWithRegisteredFps(t, r, mockClientController, 3, shared)
+
+		currentBlockRes := &types.BlockInfo{
+			Height: uint64(r.Int63n(100) + 1),
+			Hash:   datagen.GenRandomByteArray(r, 32),
+		}
+		mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBlock(gomock.Any()).Return(currentBlockRes, nil).AnyTimes()
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), uint64(1)).Return(nil, nil).AnyTimes()
+		// no public randomness is committed, so there is no proof to repair at startup
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().QueryVotesAtHeight(gomock.Any()).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderSlashedOrJailed(gomock.Any()).Return(false, false, nil).AnyTimes()
+
+		for _, fpPk := range fpPks {
+			require.NoError(t, vm.StartFinalityProvider(fpPk, passphrase))
+			require.True(t, vm.IsFinalityProviderRunning(fpPk))
+		}
+		require.Len(t, vm.ListFinalityProviderInstances(), len(fpPks))
+
+		// stopping one of the finality providers keeps the others running
+		require.NoError(t, vm.StopFinalityProvider(fpPks[0]))
+		require.False(t, vm.IsFinalityProviderRunning(fpPks[0]))
+		_, err := vm.GetFinalityProviderInstance(fpPks[0])
+		require.Error(t, err)
+		require.Error(t, vm.StopFinalityProvider(fpPks[0]))
+		for _, fpPk := range fpPks[1:] {
+			fpIns, err := vm.GetFinalityProviderInstance(fpPk)
+			require.NoError(t, err)
+			require.True(t, fpIns.IsRunning())
+		}
+		running, err := vm.RunningFinalityProviders()
+		require.NoError(t, err)
+		require.Len(t, running, len(fpPks)-1)
+
+		// the stopped finality provider can be started again
+		require.NoError(t, vm.StartFinalityProvider(fpPks[0], passphrase))
+		require.True(t, vm.IsFinalityProviderRunning(fpPks[0]))
+		require.Len(t, vm.ListFinalityProviderInstances(), len(fpPks))
+
+		cleanUp()
+	}
+}
+
+// TestPauseResumeFinalityProvider tests that a paused finality provider keeps running without
+// voting, and the blocks it skips while paused are not voted by the fast sync after resuming
+func TestPauseResumeFinalityProvider(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	ctl := gomock.NewController(t)
+	mockClientController := mocks.NewMockClientController(ctl)
+	vm, fpPk, cleanUp := newFinalityProviderManagerWithRegisteredFp(t, r, mockClientController)
+	defer cleanUp()
+
+	// the current block is far enough from the finalized one to trigger the fast sync
+	// if the blocks skipped while paused were not processed
+	finalizedBlockRes := &types.BlockInfo{
+		Height: 1,
+		Hash:   datagen.GenRandomByteArray(r, 32),
+	}
+	currentBlockRes := &types.BlockInfo{
+		Height: uint64(r.Int63n(100)) + fpcfg.DefaultConfig().FastSyncGap + 1,
+		Hash:   datagen.GenRandomByteArray(r, 32),
+	}
+	mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
+	mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return([]*types.BlockInfo{finalizedBlockRes}, nil).AnyTimes()
+	mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+	mockClientController.EXPECT().QueryBlock(gomock.Any()).Return(currentBlockRes, nil).AnyTimes()
+	mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), uint64(1)).Return(nil, nil).AnyTimes()
+	// no public randomness is committed, so there is no proof to repair at startup
+	mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockClientController.EXPECT().QueryVotesAtHeight(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+	mockClientController.EXPECT().QueryFinalityProviderSlashedOrJailed(gomock.Any()).Return(false, false, nil).AnyTimes()
+
+	require.NoError(t, vm.PauseFinalityProvider(fpPk))
+	require.NoError(t, vm.StartFinalityProvider(fpPk, passphrase))
+	fpIns, err := vm.GetFinalityProviderInstance(fpPk)
+	require.NoError(t, err)
+	require.True(t, fpIns.IsRunning())
+	require.True(t, fpIns.IsPaused())
+	fpInfo, err := vm.FinalityProviderInfo(fpPk)
+	require.NoError(t, err)
+	require.True(t, fpInfo.IsPaused)
+
+	// the paused finality provider processes the blocks without voting
+	require.Eventually(t, func() bool {
+		return fpIns.GetLastProcessedHeight() == currentBlockRes.Height
+	}, eventuallyWaitTimeOut, eventuallyPollTime)
+	require.Zero(t, fpIns.GetLastVotedHeight())
+
+	// resuming the stopped finality provider starts it without fast syncing the
+	// skipped blocks, as no blocks are queried for the fast sync
+	require.NoError(t, vm.StopFinalityProvider(fpPk))
+	require.NoError(t, vm.ResumeFinalityProvider(fpPk, passphrase))
+	fpIns, err = vm.GetFinalityProviderInstance(fpPk)
+	require.NoError(t, err)
+	require.True(t, fpIns.IsRunning())
+	require.False(t, fpIns.IsPaused())
+	fpInfo, err = vm.FinalityProviderInfo(fpPk)
+	require.NoError(t, err)
+	require.False(t, fpInfo.IsPaused)
+}
+
 func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc clientcontroller.ClientController) (*service.FinalityProviderManager, *bbntypes.BIP340PubKey, func()) {
+	vm, fpPks, cleanUp := newFinalityProviderManagerWithRegisteredFps(t, r, cc, 1, false)
+
+	return vm, fpPks[0], cleanUp
+}
+
+func newFinalityProviderManagerWithRegisteredFps(
+	t *testing.T,
+	r *rand.Rand,
+	cc clientcontroller.ClientController,
+	n int,
+	sharedPoller bool,
+) (*service.FinalityProviderManager, []*bbntypes.BIP340PubKey, func()) {
 	logger := zap.NewNop()
 	// create an EOTS manager
 	eotsHomeDir := filepath.Join(t.TempDir(), "eots-home")
@@ -117,6 +248,7 @@ func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc c
 	fpHomeDir := filepath.Join(t.TempDir(), "fp-home")
 	fpCfg := fpcfg.DefaultConfigWithHome(fpHomeDir)
 	fpCfg.StatusUpdateInterval = 10 * time.Millisecond
+	fpCfg.PollerConfig.Shared = sharedPoller
 	input := strings.NewReader("")
 	kr, err := keyring.CreateKeyring(
 		fpCfg.BabylonConfig.KeyDirectory,
@@ -134,39 +266,46 @@ func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc c
 	pubRandStore, err := fpstore.NewPubRandProofStore(db)
 	require.NoError(t, err)
 
-	metricsCollectors := metrics.NewFpMetrics()
-	vm, err := service.NewFinalityProviderManager(fpStore, pubRandStore, &fpCfg, cc, em, metricsCollectors, logger)
+	voteJournal, err := fpstore.NewVoteJournalStore(db)
 	require.NoError(t, err)
 
-	// create registered finality-provider
-	keyName := datagen.GenRandomHexStr(r, 10)
-	chainID := datagen.GenRandomHexStr(r, 10)
-	kc, err := keyring.NewChainKeyringControllerWithKeyring(kr, keyName, input)
-	require.NoError(t, err)
-	btcPkBytes, err := em.CreateKey(keyName, passphrase, hdPath)
-	require.NoError(t, err)
-	btcPk, err := bbntypes.NewBIP340PubKey(btcPkBytes)
-	require.NoError(t, err)
-	keyInfo, err := kc.CreateChainKey(passphrase, hdPath, "")
-	require.NoError(t, err)
-	fpAddr := keyInfo.AccAddress
-	fpRecord, err := em.KeyRecord(btcPk.MustMarshal(), passphrase)
-	require.NoError(t, err)
-	pop, err := kc.CreatePop(fpAddr, fpRecord.PrivKey)
+	metricsCollectors := metrics.NewFpMetrics()
+	vm, err := service.NewFinalityProviderManager(fpStore, pubRandStore, voteJournal, &fpCfg, cc, em, metricsCollectors, logger)
 	require.NoError(t, err)
 
-	err = fpStore.CreateFinalityProvider(
-		fpAddr,
-		btcPk.MustToBTCPK(),
-		testutil.RandomDescription(r),
-		testutil.ZeroCommissionRate(),
-		keyName,
-		chainID,
-		pop.BtcSig,
-	)
-	require.NoError(t, err)
-	err = fpStore.SetFpStatus(btcPk.MustToBTCPK(), proto.FinalityProviderStatus_REGISTERED)
-	require.NoError(t, err)
+	// create registered finality-providers
+	btcPks := make([]*bbntypes.BIP340PubKey, 0, n)
+	for i := 0; i < n; i++ {
+		keyName := datagen.GenRandomHexStr(r, 10)
+		chainID := datagen.GenRandomHexStr(r, 10)
+		kc, err := keyring.NewChainKeyringControllerWithKeyring(kr, keyName, input)
+		require.NoError(t, err)
+		btcPkBytes, err := em.CreateKey(keyName, passphrase, hdPath)
+		require.NoError(t, err)
+		btcPk, err := bbntypes.NewBIP340PubKey(btcPkBytes)
+		require.NoError(t, err)
+		keyInfo, err := kc.CreateChainKey(passphrase, hdPath, "")
+		require.NoError(t, err)
+		fpAddr := keyInfo.AccAddress
+		fpRecord, err := em.KeyRecord(btcPk.MustMarshal(), passphrase)
+		require.NoError(t, err)
+		pop, err := kc.CreatePop(fpAddr, fpRecord.PrivKey)
+		require.NoError(t, err)
+
+		err = fpStore.CreateFinalityProvider(
+			fpAddr,
+			btcPk.MustToBTCPK(),
+			testutil.RandomDescription(r),
+			testutil.ZeroCommissionRate(),
+			keyName,
+			chainID,
+			pop.BtcSig,
+		)
+		require.NoError(t, err)
+		err = fpStore.SetFpStatus(btcPk.MustToBTCPK(), proto.FinalityProviderStatus_REGISTERED)
+		require.NoError(t, err)
+		btcPks = append(btcPks, btcPk)
+	}
 
 	cleanUp := func() {
 		err = vm.Stop()
@@ -181,5 +320,5 @@ func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc c
 		require.NoError(t, err)
 	}
 
-	return vm, btcPk, cleanUp
+	return vm, btcPks, cleanUp
 }
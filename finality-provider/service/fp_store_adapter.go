@@ -13,6 +13,7 @@ import (
 
 	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/types"
 )
 
 type createFinalityProviderResponse struct {
@@ -87,6 +88,19 @@ func (fps *fpState) setStatus(s proto.FinalityProviderStatus) error {
 	return fps.s.SetFpStatus(fps.fp.BtcPk, s)
 }
 
+func (fps *fpState) isPaused() bool {
+	fps.mu.Lock()
+	defer fps.mu.Unlock()
+	return fps.fp.Paused
+}
+
+func (fps *fpState) setPaused(paused bool) error {
+	fps.mu.Lock()
+	fps.fp.Paused = paused
+	fps.mu.Unlock()
+	return fps.s.SetFpPaused(fps.fp.BtcPk, paused)
+}
+
 func (fps *fpState) setLastProcessedHeight(height uint64) error {
 	fps.mu.Lock()
 	fps.fp.LastProcessedHeight = height
@@ -102,6 +116,42 @@ func (fps *fpState) setLastProcessedAndVotedHeight(height uint64) error {
 	return fps.s.SetFpLastVotedHeight(fps.fp.BtcPk, height)
 }
 
+// setRecoveredHeights raises the last voted and processed heights to the recovered ones,
+// leaving the heights that are higher already as they are
+func (fps *fpState) setRecoveredHeights(lastVotedHeight, lastProcessedHeight uint64) error {
+	if lastProcessedHeight < lastVotedHeight {
+		lastProcessedHeight = lastVotedHeight
+	}
+
+	fps.mu.Lock()
+	if fps.fp.LastVotedHeight < lastVotedHeight {
+		fps.fp.LastVotedHeight = lastVotedHeight
+	}
+	if fps.fp.LastProcessedHeight < lastProcessedHeight {
+		fps.fp.LastProcessedHeight = lastProcessedHeight
+	}
+	fps.mu.Unlock()
+
+	if err := fps.s.SetFpLastVotedHeight(fps.fp.BtcPk, lastVotedHeight); err != nil {
+		return err
+	}
+
+	return fps.s.SetFpLastProcessedHeight(fps.fp.BtcPk, lastProcessedHeight)
+}
+
+// saveSignedBlocks records the hashes of the blocks to be signed, which fails if a
+// different block has been signed at any of the heights
+func (fps *fpState) saveSignedBlocks(blocks []*types.BlockInfo) error {
+	heights := make([]uint64, 0, len(blocks))
+	hashes := make([][]byte, 0, len(blocks))
+	for _, b := range blocks {
+		heights = append(heights, b.Height)
+		hashes = append(hashes, b.Hash)
+	}
+
+	return fps.s.SaveSignedBlocks(fps.getStoreFinalityProvider().BtcPk, heights, hashes)
+}
+
 func (fp *FinalityProviderInstance) GetStoreFinalityProvider() *store.StoredFinalityProvider {
 	return fp.fpState.getStoreFinalityProvider()
 }
@@ -122,6 +172,12 @@ func (fp *FinalityProviderInstance) GetStatus() proto.FinalityProviderStatus {
 	return fp.fpState.getStoreFinalityProvider().Status
 }
 
+// IsPaused returns whether the finality provider is paused, which keeps committing
+// public randomness but submits no finality signatures
+func (fp *FinalityProviderInstance) IsPaused() bool {
+	return fp.fpState.isPaused()
+}
+
 func (fp *FinalityProviderInstance) GetLastVotedHeight() uint64 {
 	return fp.fpState.getStoreFinalityProvider().LastVotedHeight
 }
@@ -145,6 +201,12 @@ func (fp *FinalityProviderInstance) MustSetStatus(s proto.FinalityProviderStatus
 	}
 }
 
+// SetPaused pauses or resumes the finality provider, and stores the state so that a paused
+// finality provider is not started automatically after the daemon restarts
+func (fp *FinalityProviderInstance) SetPaused(paused bool) error {
+	return fp.fpState.setPaused(paused)
+}
+
 func (fp *FinalityProviderInstance) SetLastProcessedHeight(height uint64) error {
 	return fp.fpState.setLastProcessedHeight(height)
 }
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	"github.com/babylonlabs-io/finality-provider/gateway"
+)
+
+// startGateway starts the REST gateway of the finality provider daemon, which calls the
+// RPC server in process and shares its authentication and unix socket configs
+func (s *Server) startGateway() (*gateway.Server, error) {
+	register := func(ctx context.Context, mux *runtime.ServeMux) error {
+		return proto.RegisterFinalityProvidersHandlerServer(ctx, mux, s.rpcServer)
+	}
+	gw, err := gateway.NewServer(s.cfg.Gateway, s.cfg.RPCAuth, proto.OpenAPISpec, register, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gw.Start(s.cfg.RPCSocket); err != nil {
+		return nil, fmt.Errorf("failed to start the REST gateway: %w", err)
+	}
+
+	return gw, nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
+	"github.com/babylonlabs-io/finality-provider/health"
+)
+
+// The subsystems of the finality provider daemon reported by the health service
+const (
+	healthSubsystemDB          = "db"
+	healthSubsystemKeyring     = "keyring"
+	healthSubsystemEOTSManager = "eotsmanager"
+	healthSubsystemPoller      = "poller"
+)
+
+// newHealthChecker creates the health checker of the finality provider daemon, which is not
+// ready if its database or keyring cannot be read, the connection to the EOTS manager is
+// broken, or the finality provider is not running or lags behind the chain
+func newHealthChecker(app *FinalityProviderApp, db kvdb.Backend, logger *zap.Logger) *health.Checker {
+	checker := health.NewChecker(logger)
+	checker.AddCheck(healthSubsystemDB, health.DBCheck(db))
+	checker.AddCheck(healthSubsystemKeyring, health.KeyringCheck(
+		app.config.BabylonConfig.KeyDirectory,
+		app.config.BabylonConfig.KeyringBackend,
+	))
+	checker.AddCheck(healthSubsystemEOTSManager, app.checkEOTSManager)
+	checker.AddCheck(healthSubsystemPoller, app.checkPoller)
+
+	return checker
+}
+
+// checkEOTSManager checks the connection to the remote EOTS manager, while the EOTS manager
+// running in the same process is always healthy
+func (app *FinalityProviderApp) checkEOTSManager() error {
+	emClient, ok := app.eotsManager.(*client.EOTSManagerGRpcClient)
+	if !ok {
+		return nil
+	}
+
+	return emClient.CheckConnectivity()
+}
+
+// checkPoller checks the finality providers are running and do not lag behind the chain tip
+// by more than the configured maximum lag, where the finality providers stopped explicitly
+// are not checked
+func (app *FinalityProviderApp) checkPoller() error {
+	fpis := app.fpManager.ListFinalityProviderInstances()
+	if len(fpis) == 0 {
+		return fmt.Errorf("no finality provider is running")
+	}
+	for _, fpi := range fpis {
+		if !fpi.IsRunning() {
+			return fmt.Errorf("the finality provider %s is not running", fpi.GetBtcPkHex())
+		}
+	}
+
+	maxLag := app.config.PollerConfig.MaxLag
+	if maxLag == 0 {
+		return nil
+	}
+
+	tip, err := app.cc.QueryBestBlock()
+	if err != nil {
+		return fmt.Errorf("failed to query the chain tip: %w", err)
+	}
+	for _, fpi := range fpis {
+		lastProcessedHeight := fpi.GetLastProcessedHeight()
+		if tip.Height > lastProcessedHeight+maxLag {
+			return fmt.Errorf("the finality provider %s lags behind the chain tip %d at height %d",
+				fpi.GetBtcPkHex(), tip.Height, lastProcessedHeight)
+		}
+	}
+
+	return nil
+}
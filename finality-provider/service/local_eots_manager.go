@@ -0,0 +1,45 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+)
+
+// inProcessEOTSManager is an EOTS manager running in the fpd process
+// It owns its db, which is closed together with the manager
+type inProcessEOTSManager struct {
+	*eotsmanager.LocalEOTSManager
+
+	db kvdb.Backend
+}
+
+func newInProcessEOTSManager(cfg *fpcfg.EOTSManagerConfig, logger *zap.Logger) (*inProcessEOTSManager, error) {
+	db, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EOTS db backend: %w", err)
+	}
+
+	lm, err := eotsmanager.NewLocalEOTSManager(cfg.KeyDirectory, cfg.KeyringBackend, db, logger)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create EOTS manager: %w", err)
+	}
+
+	return &inProcessEOTSManager{
+		LocalEOTSManager: lm,
+		db:               db,
+	}, nil
+}
+
+func (em *inProcessEOTSManager) Close() error {
+	if err := em.LocalEOTSManager.Close(); err != nil {
+		return err
+	}
+
+	return em.db.Close()
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/avast/retry-go/v4"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// pubRandRepairNumCommits is the number of the latest public randomness commits checked by
+// the repair, which is more than enough to cover the heights yet to be voted as each commit
+// covers a large range of heights
+const pubRandRepairNumCommits = 10
+
+// PubRandRepairResult is the result of repairing the inclusion proofs of the
+// public randomness committed by a finality provider
+type PubRandRepairResult struct {
+	// NumCheckedCommits is the number of the commits covering the heights yet to be voted
+	NumCheckedCommits uint64
+	// NumRepairedProofs is the number of the inclusion proofs regenerated and saved
+	NumRepairedProofs uint64
+}
+
+// repairPubRandProofs regenerates the inclusion proofs of the public randomness committed on
+// the consumer chain for the heights above the last voted height, and saves the ones missing
+// from the proof store. The heights below the prune height of the proofs are skipped as well,
+// so that the repair does not save the proofs the pruning deletes. Only the commits with proofs
+// missing from the store are regenerated, one at a time. The randomness is deterministic per
+// key, chain ID and height, so it is regenerated by the EOTS manager, and the Merkle root of
+// each regenerated commit is checked against the commitment on the consumer chain before
+// saving its proofs
+func repairPubRandProofs(
+	cc clientcontroller.ClientController,
+	em eotsmanager.EOTSManager,
+	pubRandStore *store.PubRandProofStore,
+	pruningCfg *fpcfg.PubRandPruningConfig,
+	fpPk *bbntypes.BIP340PubKey,
+	chainID []byte,
+	passphrase string,
+	lastVotedHeight uint64,
+	logger *zap.Logger,
+) (*PubRandRepairResult, error) {
+	var pruneHeight uint64
+	if err := retry.Do(func() error {
+		var err error
+		pruneHeight, err = pubRandPruneHeight(cc, pruningCfg)
+		return err
+	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+		logger.Debug(
+			"failed to query babylon for the latest finalized block",
+			zap.Uint("attempt", n+1),
+			zap.Uint("max_attempts", RtyAttNum),
+			zap.Error(err),
+		)
+	})); err != nil {
+		return nil, err
+	}
+	// the first height whose proof is needed
+	fromHeight := max(lastVotedHeight+1, pruneHeight)
+
+	var commitMap map[uint64]*ftypes.PubRandCommitResponse
+	if err := retry.Do(func() error {
+		var err error
+		commitMap, err = cc.QueryLastCommittedPublicRand(fpPk.MustToBTCPK(), pubRandRepairNumCommits)
+		return err
+	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+		logger.Debug(
+			"failed to query babylon for the committed public randomness",
+			zap.String("pk", fpPk.MarshalHex()),
+			zap.Uint("attempt", n+1),
+			zap.Uint("max_attempts", RtyAttNum),
+			zap.Error(err),
+		)
+	})); err != nil {
+		return nil, fmt.Errorf("failed to query the public randomness committed by the finality provider %s: %w",
+			fpPk.MarshalHex(), err)
+	}
+
+	startHeights := make([]uint64, 0, len(commitMap))
+	for startHeight := range commitMap {
+		startHeights = append(startHeights, startHeight)
+	}
+	sort.Slice(startHeights, func(i, j int) bool { return startHeights[i] < startHeights[j] })
+
+	res := &PubRandRepairResult{}
+	for _, startHeight := range startHeights {
+		commit := commitMap[startHeight]
+		endHeight := startHeight + commit.NumPubRand - 1
+		if commit.NumPubRand == 0 || endHeight < fromHeight {
+			// the heights of the commit are voted or pruned already
+			continue
+		}
+		if commit.NumPubRand > math.MaxUint32 {
+			return nil, fmt.Errorf("the number of public randomness %d committed at height %d is too large",
+				commit.NumPubRand, startHeight)
+		}
+		res.NumCheckedCommits++
+
+		// 1. skip the commit if none of its proofs needed are missing from the store
+		saveFromHeight := max(startHeight, fromHeight)
+		numStored, err := pubRandStore.CountPubRandProofs(fpPk.MustToBTCPK(), chainID, saveFromHeight, endHeight)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count the stored public randomness proofs: %w", err)
+		}
+		if numStored == endHeight-saveFromHeight+1 {
+			continue
+		}
+
+		// 2. regenerate the commit and check it against the one on the consumer chain
+		// #nosec G115 -- performed the conversion check above
+		pubRandList, err := em.CreateRandomnessPairList(fpPk.MustMarshal(), chainID, startHeight, uint32(commit.NumPubRand), passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("failed to regenerate the public randomness committed at height %d: %w", startHeight, err)
+		}
+
+		commitment, proofList := types.GetPubRandCommitAndProofs(pubRandList)
+		if !bytes.Equal(commitment, commit.Commitment) {
+			return nil, fmt.Errorf("the regenerated commitment of the public randomness at height %d does not match the one on the consumer chain, "+
+				"please check the chain ID %s and the EOTS key of the finality provider %s", startHeight, string(chainID), fpPk.MarshalHex())
+		}
+
+		// 3. save the proofs needed that are missing or differ
+		offset := saveFromHeight - startHeight
+		numRepaired, err := pubRandStore.RepairPubRandProofList(fpPk.MustToBTCPK(), chainID, saveFromHeight, pubRandList[offset:], proofList[offset:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to save the regenerated public randomness proofs: %w", err)
+		}
+		if numRepaired == 0 {
+			continue
+		}
+
+		logger.Warn(
+			"the public randomness proofs of the finality provider are missing in the local db, regenerated",
+			zap.String("pk", fpPk.MarshalHex()),
+			zap.Uint64("start_height", startHeight),
+			zap.Int("num_pub_rand", len(pubRandList)),
+			zap.Uint64("num_repaired_proofs", numRepaired),
+		)
+		res.NumRepairedProofs += numRepaired
+	}
+
+	return res, nil
+}
@@ -0,0 +1,147 @@
+package service_test
+
+import (
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager"
+	eotscfg "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+	"github.com/babylonlabs-io/finality-provider/testutil/mocks"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// FuzzRepairPubRandProofs tests regenerating the inclusion proofs of the committed public
+// randomness that are missing from the local db, which are only saved if the regenerated
+// commitment matches the one on the consumer chain, and are not saved below the prune height
+func FuzzRepairPubRandProofs(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		logger := zap.NewNop()
+		// create an EOTS manager
+		eotsHomeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(eotsHomeDir)
+		eotsdb, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, eotsdb.Close())
+		}()
+		em, err := eotsmanager.NewLocalEOTSManager(eotsHomeDir, eotsCfg.KeyringBackend, eotsdb, logger)
+		require.NoError(t, err)
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+
+		fpCfg := config.DefaultConfigWithHome(filepath.Join(t.TempDir(), "fp-home"))
+		fpdb, err := fpCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, fpdb.Close())
+		}()
+		app, err := service.NewFinalityProviderApp(&fpCfg, mockClientController, em, fpdb, logger)
+		require.NoError(t, err)
+		err = app.Start()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, app.Stop())
+		}()
+
+		fp := testutil.GenStoredFinalityProvider(r, t, app, passphrase, hdPath, nil)
+		fpPk := fp.GetBIP340BTCPK()
+
+		// the public randomness is committed on the consumer chain, while the
+		// proofs are missing from the local db
+		startHeight := uint64(r.Int63n(100) + 1)
+		numPubRand := uint64(r.Int63n(100) + 1)
+		pubRandList, err := em.CreateRandomnessPairList(fpPk.MustMarshal(), []byte(fp.ChainID), startHeight, uint32(numPubRand), passphrase)
+		require.NoError(t, err)
+		commitment, proofList := types.GetPubRandCommitAndProofs(pubRandList)
+
+		onChainCommitment := datagen.GenRandomByteArray(r, 32)
+		mockClientController.EXPECT().QueryLastCommittedPublicRand(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ *btcec.PublicKey, _ uint64) (map[uint64]*ftypes.PubRandCommitResponse, error) {
+				return map[uint64]*ftypes.PubRandCommitResponse{
+					startHeight: {NumPubRand: numPubRand, Commitment: onChainCommitment},
+				}, nil
+			}).AnyTimes()
+		var finalizedHeight uint64
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(uint64(1)).
+			DoAndReturn(func(_ uint64) ([]*types.BlockInfo, error) {
+				if finalizedHeight == 0 {
+					return nil, nil
+				}
+				return []*types.BlockInfo{{Height: finalizedHeight}}, nil
+			}).AnyTimes()
+
+		// nothing is saved if the regenerated commitment does not match the one on chain
+		_, err = app.RepairPubRandProofs(fpPk, passphrase)
+		require.ErrorContains(t, err, "does not match")
+		_, err = app.GetPubRandProofStore().GetPubRandProof(fpPk.MustToBTCPK(), []byte(fp.ChainID), startHeight, pubRandList[0])
+		require.ErrorIs(t, err, store.ErrPubRandProofNotFound)
+
+		onChainCommitment = commitment
+		res, err := app.RepairPubRandProofs(fpPk, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.NumCheckedCommits)
+		require.Equal(t, numPubRand, res.NumRepairedProofs)
+
+		proofBytesList, err := app.GetPubRandProofStore().GetPubRandProofList(fpPk.MustToBTCPK(), []byte(fp.ChainID), startHeight, pubRandList)
+		require.NoError(t, err)
+		for i, proofBytes := range proofBytesList {
+			expectedProofBytes, err := proofList[i].ToProto().Marshal()
+			require.NoError(t, err)
+			require.Equal(t, expectedProofBytes, proofBytes)
+		}
+
+		// the repair is idempotent
+		res, err = app.RepairPubRandProofs(fpPk, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.NumCheckedCommits)
+		require.Zero(t, res.NumRepairedProofs)
+
+		// only the proofs missing from a commit are saved
+		numMissing := uint64(r.Int63n(int64(numPubRand)) + 1)
+		numPruned, err := app.GetPubRandProofStore().PrunePubRandProofs(fpPk.MustToBTCPK(), []byte(fp.ChainID), startHeight+numMissing)
+		require.NoError(t, err)
+		require.Equal(t, numMissing, numPruned)
+		res, err = app.RepairPubRandProofs(fpPk, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.NumCheckedCommits)
+		require.Equal(t, numMissing, res.NumRepairedProofs)
+
+		// the proofs below the prune height are not saved
+		numPruned = uint64(r.Int63n(int64(numPubRand)))
+		finalizedHeight = startHeight + numPruned + fpCfg.PubRandPruning.SafetyMargin
+		_, err = app.GetPubRandProofStore().PrunePubRandProofs(fpPk.MustToBTCPK(), []byte(fp.ChainID), startHeight+numPubRand)
+		require.NoError(t, err)
+		res, err = app.RepairPubRandProofs(fpPk, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.NumCheckedCommits)
+		require.Equal(t, numPubRand-numPruned, res.NumRepairedProofs)
+		numStored, err := app.GetPubRandProofStore().CountPubRandProofs(fpPk.MustToBTCPK(), []byte(fp.ChainID), startHeight, startHeight+numPubRand-1)
+		require.NoError(t, err)
+		require.Equal(t, numPubRand-numPruned, numStored)
+
+		// the commits whose heights are all voted are not checked
+		err = app.GetFinalityProviderStore().SetFpLastVotedHeight(fpPk.MustToBTCPK(), startHeight+numPubRand-1)
+		require.NoError(t, err)
+		res, err = app.RepairPubRandProofs(fpPk, passphrase)
+		require.NoError(t, err)
+		require.Zero(t, res.NumCheckedCommits)
+		require.Zero(t, res.NumRepairedProofs)
+	})
+}
@@ -15,16 +15,29 @@ func NewPubRandState(s *store.PubRandProofStore) *pubRandState {
 }
 
 func (st *pubRandState) AddPubRandProofList(
+	btcPk *btcec.PublicKey,
+	chainID []byte,
+	startHeight uint64,
 	pubRandList []*btcec.FieldVal,
 	proofList []*merkle.Proof,
 ) error {
-	return st.s.AddPubRandProofList(pubRandList, proofList)
+	return st.s.AddPubRandProofList(btcPk, chainID, startHeight, pubRandList, proofList)
 }
 
-func (st *pubRandState) GetPubRandProof(pubRand *btcec.FieldVal) ([]byte, error) {
-	return st.s.GetPubRandProof(pubRand)
+func (st *pubRandState) GetPubRandProof(
+	btcPk *btcec.PublicKey,
+	chainID []byte,
+	height uint64,
+	pubRand *btcec.FieldVal,
+) ([]byte, error) {
+	return st.s.GetPubRandProof(btcPk, chainID, height, pubRand)
 }
 
-func (st *pubRandState) GetPubRandProofList(pubRandList []*btcec.FieldVal) ([][]byte, error) {
-	return st.s.GetPubRandProofList(pubRandList)
+func (st *pubRandState) GetPubRandProofList(
+	btcPk *btcec.PublicKey,
+	chainID []byte,
+	startHeight uint64,
+	pubRandList []*btcec.FieldVal,
+) ([][]byte, error) {
+	return st.s.GetPubRandProofList(btcPk, chainID, startHeight, pubRandList)
 }
@@ -17,6 +17,14 @@ import (
 	"github.com/babylonlabs-io/finality-provider/version"
 )
 
+const (
+	// defaultQueryVotesLimit is the number of the votes returned by QueryVotes if the limit
+	// of the request is not set
+	defaultQueryVotesLimit = uint32(100)
+	// maxQueryVotesLimit is the maximum number of the votes returned by QueryVotes
+	maxQueryVotesLimit = uint32(1000)
+)
+
 // rpcServer is the main RPC server for the Finality Provider daemon that handles
 // gRPC incoming requests.
 type rpcServer struct {
@@ -147,17 +155,11 @@ func (r *rpcServer) AddFinalitySignature(ctx context.Context, req *proto.AddFina
 		return nil, err
 	}
 
-	fpi, err := r.app.GetFinalityProviderInstance()
+	fpi, err := r.app.GetFinalityProviderInstance(fpPk)
 	if err != nil {
 		return nil, err
 	}
 
-	if fpi.GetBtcPkHex() != req.BtcPk {
-		return nil, fmt.Errorf(
-			"the finality provider running does not match the request, got: %s, expected: %s",
-			req.BtcPk, fpi.GetBtcPkHex())
-	}
-
 	b := &types.BlockInfo{
 		Height: req.Height,
 		Hash:   req.AppHash,
@@ -173,21 +175,14 @@ func (r *rpcServer) AddFinalitySignature(ctx context.Context, req *proto.AddFina
 	// if privKey is not empty, then this BTC finality-provider
 	// has voted for a fork and will be slashed
 	if privKey != nil {
-		localPrivKey, err := r.app.getFpPrivKey(fpPk.MustMarshal())
 		res.ExtractedSkHex = privKey.Key.String()
-		if err != nil {
-			return nil, err
-		}
-		localSkHex := localPrivKey.Key.String()
-		localSkNegateHex := localPrivKey.Key.Negate().String()
-		if res.ExtractedSkHex == localSkHex {
-			res.LocalSkHex = localSkHex
-		} else if res.ExtractedSkHex == localSkNegateHex {
-			res.LocalSkHex = localSkNegateHex
-		} else {
+		// the private key does not leave the EOTS manager, so the extracted key is checked
+		// against the public key, which matches both the local key and its negation
+		extractedPk := bbntypes.NewBIP340PubKeyFromBTCPK(privKey.PubKey())
+		if !extractedPk.Equals(fpPk) {
 			return nil, fmt.Errorf("the finality-provider's BTC private key is extracted but does not match the local key,"+
-				"extrated: %s, local: %s, local-negated: %s",
-				res.ExtractedSkHex, localSkHex, localSkNegateHex)
+				"extrated: %s, extracted pk: %s, local pk: %s",
+				res.ExtractedSkHex, extractedPk.MarshalHex(), fpPk.MarshalHex())
 		}
 	}
 
@@ -273,6 +268,169 @@ func (r *rpcServer) QueryFinalityProviderList(ctx context.Context, req *proto.Qu
 	return &proto.QueryFinalityProviderListResponse{FinalityProviders: fps}, nil
 }
 
+// QueryRunningFinalityProviderList queries the information of the finality providers whose
+// instances are running
+func (r *rpcServer) QueryRunningFinalityProviderList(ctx context.Context, req *proto.QueryRunningFinalityProviderListRequest) (
+	*proto.QueryRunningFinalityProviderListResponse, error) {
+
+	fps, err := r.app.ListRunningFinalityProvidersInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.QueryRunningFinalityProviderListResponse{FinalityProviders: fps}, nil
+}
+
+// StartFinalityProvider starts the instance of a finality provider
+func (r *rpcServer) StartFinalityProvider(ctx context.Context, req *proto.StartFinalityProviderRequest) (
+	*proto.EmptyResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.app.StartHandlingFinalityProvider(fpPk, req.Passphrase); err != nil {
+		return nil, fmt.Errorf("failed to start the finality provider instance: %w", err)
+	}
+
+	return &proto.EmptyResponse{}, nil
+}
+
+// StopFinalityProvider stops the running instance of a finality provider
+func (r *rpcServer) StopFinalityProvider(ctx context.Context, req *proto.StopFinalityProviderRequest) (
+	*proto.EmptyResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.app.StopHandlingFinalityProvider(fpPk); err != nil {
+		return nil, fmt.Errorf("failed to stop the finality provider instance: %w", err)
+	}
+
+	return &proto.EmptyResponse{}, nil
+}
+
+// PauseFinalityProvider pauses a finality provider, which keeps committing public randomness
+// but submits no finality signatures until it is resumed
+func (r *rpcServer) PauseFinalityProvider(ctx context.Context, req *proto.PauseFinalityProviderRequest) (
+	*proto.EmptyResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.app.PauseHandlingFinalityProvider(fpPk); err != nil {
+		return nil, fmt.Errorf("failed to pause the finality provider: %w", err)
+	}
+
+	return &proto.EmptyResponse{}, nil
+}
+
+// ResumeFinalityProvider resumes a paused finality provider
+func (r *rpcServer) ResumeFinalityProvider(ctx context.Context, req *proto.ResumeFinalityProviderRequest) (
+	*proto.EmptyResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.app.ResumeHandlingFinalityProvider(fpPk, req.Passphrase); err != nil {
+		return nil, fmt.Errorf("failed to resume the finality provider: %w", err)
+	}
+
+	return &proto.EmptyResponse{}, nil
+}
+
+// QueryVotes queries the votes of a finality provider recorded in the vote journal
+func (r *rpcServer) QueryVotes(ctx context.Context, req *proto.QueryVotesRequest) (
+	*proto.QueryVotesResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultQueryVotesLimit
+	}
+	if limit > maxQueryVotesLimit {
+		return nil, fmt.Errorf("the limit %d exceeds the maximum %d", limit, maxQueryVotesLimit)
+	}
+
+	votes, nextHeight, err := r.app.QueryVotes(fpPk, req.StartHeight, limit, req.Reverse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.QueryVotesResponse{Votes: votes, NextHeight: nextHeight}, nil
+}
+
+// RecoverFinalityProviderState recovers the last voted and processed heights of the
+// finality provider from its votes on the consumer chain
+func (r *rpcServer) RecoverFinalityProviderState(ctx context.Context, req *proto.RecoverFinalityProviderStateRequest) (
+	*proto.RecoverFinalityProviderStateResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	fp, err := r.app.RecoverFinalityProviderState(fpPk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RecoverFinalityProviderStateResponse{
+		LastVotedHeight:     fp.LastVotedHeight,
+		LastProcessedHeight: fp.LastProcessedHeight,
+	}, nil
+}
+
+// RepairPubRandProofs regenerates the inclusion proofs of the public randomness
+// committed by the finality provider that are missing from the local db
+func (r *rpcServer) RepairPubRandProofs(ctx context.Context, req *proto.RepairPubRandProofsRequest) (
+	*proto.RepairPubRandProofsResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.app.RepairPubRandProofs(fpPk, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RepairPubRandProofsResponse{
+		NumCheckedCommits: res.NumCheckedCommits,
+		NumRepairedProofs: res.NumRepairedProofs,
+	}, nil
+}
+
+// ImportFinalityProvider rebuilds the local record of a finality provider
+// registered on the consumer chain
+func (r *rpcServer) ImportFinalityProvider(ctx context.Context, req *proto.ImportFinalityProviderRequest) (
+	*proto.ImportFinalityProviderResponse, error) {
+
+	eotsPk, err := bbntypes.NewBIP340PubKeyFromHex(req.EotsPkHex)
+	if err != nil {
+		return nil, err
+	}
+
+	fpInfo, err := r.app.ImportFinalityProvider(req.KeyName, req.ChainId, req.Passphrase, eotsPk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ImportFinalityProviderResponse{FinalityProvider: fpInfo}, nil
+}
+
 // SignMessageFromChainKey signs a message from the chain keyring.
 func (r *rpcServer) SignMessageFromChainKey(ctx context.Context, req *proto.SignMessageFromChainKeyRequest) (
 	*proto.SignMessageFromChainKeyResponse, error) {
@@ -11,9 +11,12 @@ import (
 	"github.com/lightningnetwork/lnd/signal"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 
 	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/health"
 	"github.com/babylonlabs-io/finality-provider/metrics"
+	"github.com/babylonlabs-io/finality-provider/util"
 )
 
 // Server is the main daemon construct for the Finality Provider server. It handles
@@ -26,6 +29,7 @@ type Server struct {
 	logger *zap.Logger
 
 	rpcServer   *rpcServer
+	health      *health.Checker
 	db          kvdb.Backend
 	interceptor signal.Interceptor
 
@@ -38,6 +42,7 @@ func NewFinalityProviderServer(cfg *fpcfg.Config, l *zap.Logger, fpa *FinalityPr
 		cfg:         cfg,
 		logger:      l,
 		rpcServer:   newRPCServer(fpa),
+		health:      newHealthChecker(fpa, db, l),
 		db:          db,
 		interceptor: sig,
 		quit:        make(chan struct{}, 1),
@@ -56,13 +61,20 @@ func (s *Server) RunUntilShutdown() error {
 	if err != nil {
 		return fmt.Errorf("failed to get prometheus address: %w", err)
 	}
-	metricsServer := metrics.Start(promAddr, s.logger)
+	metricsServer := metrics.Start(promAddr, s.health.Ready, s.cfg.Metrics.EnablePprof, s.logger)
 
 	defer func() {
 		s.logger.Info("Shutdown complete")
 	}()
 
 	defer func() {
+		// the app is stopped before the database is closed, which also
+		// shuts down the EOTS manager if it runs in the same process
+		s.logger.Info("Stopping finality provider app...")
+		if err := s.rpcServer.app.Stop(); err != nil {
+			s.logger.Error(fmt.Sprintf("Failed to stop finality provider app: %v", err))
+		}
+
 		s.logger.Info("Closing database...")
 		if err := s.db.Close(); err != nil {
 			s.logger.Error(fmt.Sprintf("Failed to close database: %v", err)) // Log the error
@@ -73,10 +85,13 @@ func (s *Server) RunUntilShutdown() error {
 		s.logger.Info("Metrics server stopped")
 	}()
 
+	s.health.Start()
+	defer s.health.Stop()
+
 	listenAddr := s.cfg.RpcListener
 	// we create listeners from the RPCListeners defined
 	// in the config.
-	lis, err := net.Listen("tcp", listenAddr)
+	lis, err := util.Listen(listenAddr, s.cfg.RPCSocket)
 	if err != nil {
 		return fmt.Errorf("failed to listen on %s: %w", listenAddr, err)
 	}
@@ -86,12 +101,18 @@ func (s *Server) RunUntilShutdown() error {
 		}
 	}()
 
-	grpcServer := grpc.NewServer()
+	grpcOpts, err := s.cfg.RPCAuth.ServerOptions()
+	if err != nil {
+		return fmt.Errorf("failed to set up the RPC authentication: %w", err)
+	}
+	grpcServer := grpc.NewServer(grpcOpts...)
 	defer grpcServer.Stop()
 
 	if err := s.rpcServer.RegisterWithGrpcServer(grpcServer); err != nil {
 		return fmt.Errorf("failed to register gRPC server: %w", err)
 	}
+	s.health.RegisterWithGrpcServer(grpcServer)
+	reflection.Register(grpcServer)
 
 	// All the necessary components have been registered, so we can
 	// actually start listening for requests.
@@ -99,6 +120,14 @@ func (s *Server) RunUntilShutdown() error {
 		return fmt.Errorf("failed to start gRPC listener: %v", err)
 	}
 
+	if s.cfg.Gateway.Enabled() {
+		gw, err := s.startGateway()
+		if err != nil {
+			return err
+		}
+		defer gw.Stop(context.Background())
+	}
+
 	s.logger.Info("Finality Provider Daemon is fully active!")
 
 	// Wait for shutdown signal from either a graceful server stop or from
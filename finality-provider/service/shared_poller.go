@@ -0,0 +1,440 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
+	cfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/metrics"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// SharedChainPoller polls the chain once for all the finality-provider instances subscribing
+// to it, rather than each instance polling the chain on its own. Each subscription polls the
+// blocks from its own next height and delivers them in its own goroutine, so a lagging or slow
+// instance does not hold back the others, while the subscriptions at the same height share the
+// queries of the blocks through the poller
+type SharedChainPoller struct {
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+	quit      chan struct{}
+	// activated is closed once BTC staking is activated
+	activated chan struct{}
+
+	cc      clientcontroller.ClientController
+	cfg     *cfg.ChainPollerConfig
+	metrics *metrics.FpMetrics
+	logger  *zap.Logger
+
+	mu              sync.Mutex
+	subs            map[*pollerSubscription]struct{}
+	activatedHeight uint64
+	// blocks are the queries of the blocks the subscriptions have not all passed yet
+	blocks map[uint64]*blockQuery
+}
+
+// blockQuery is a query of a block shared by the subscriptions, whose result
+// is set before done is closed
+type blockQuery struct {
+	done  chan struct{}
+	block *types.BlockInfo
+	err   error
+}
+
+func NewSharedChainPoller(
+	logger *zap.Logger,
+	cfg *cfg.ChainPollerConfig,
+	cc clientcontroller.ClientController,
+	metrics *metrics.FpMetrics,
+) *SharedChainPoller {
+	return &SharedChainPoller{
+		quit:      make(chan struct{}),
+		activated: make(chan struct{}),
+		cc:        cc,
+		cfg:       cfg,
+		metrics:   metrics,
+		logger:    logger,
+		subs:      make(map[*pollerSubscription]struct{}),
+		blocks:    make(map[uint64]*blockQuery),
+	}
+}
+
+// Subscribe returns a subscription of a finality-provider instance, which receives the
+// blocks from its start height once started. The error stopping the subscription from
+// polling the chain is passed to onErr, which is called in its own goroutine so that it
+// may block until the subscription is stopped
+func (sp *SharedChainPoller) Subscribe(onErr func(err error)) *pollerSubscription {
+	return &pollerSubscription{
+		sp:            sp,
+		onErr:         onErr,
+		isStarted:     atomic.NewBool(false),
+		nextHeight:    atomic.NewUint64(0),
+		blockInfoChan: make(chan *types.BlockInfo, sp.cfg.BufferSize),
+		wake:          make(chan struct{}, 1),
+	}
+}
+
+// Stop stops the poller, after which the subscriptions receive no more blocks
+func (sp *SharedChainPoller) Stop() {
+	sp.stopOnce.Do(func() {
+		sp.logger.Info("stopping the shared chain poller")
+		close(sp.quit)
+		sp.wg.Wait()
+	})
+}
+
+func (sp *SharedChainPoller) addSubscription(sub *pollerSubscription) {
+	sp.startOnce.Do(func() {
+		sp.logger.Info("starting the shared chain poller")
+		sp.wg.Add(1)
+		go sp.waitForActivation()
+	})
+
+	sp.mu.Lock()
+	sp.subs[sub] = struct{}{}
+	sp.mu.Unlock()
+}
+
+func (sp *SharedChainPoller) removeSubscription(sub *pollerSubscription) {
+	sp.mu.Lock()
+	delete(sp.subs, sub)
+	sp.pruneBlocks()
+	sp.mu.Unlock()
+}
+
+// waitForActivation waits until BTC staking is activated
+func (sp *SharedChainPoller) waitForActivation() {
+	defer sp.wg.Done()
+
+	for {
+		activatedHeight, err := sp.cc.QueryActivatedHeight()
+		if err != nil {
+			sp.logger.Debug("failed to query the consumer chain for the activated height", zap.Error(err))
+		} else {
+			sp.mu.Lock()
+			sp.activatedHeight = activatedHeight
+			sp.mu.Unlock()
+			close(sp.activated)
+			return
+		}
+
+		select {
+		case <-time.After(sp.cfg.PollInterval):
+
+		case <-sp.quit:
+			return
+		}
+	}
+}
+
+// getActivatedHeight returns the activated height once BTC staking is activated,
+// and false if the poller or the subscription stops before
+func (sp *SharedChainPoller) getActivatedHeight(quit <-chan struct{}) (uint64, bool) {
+	select {
+	case <-sp.activated:
+	case <-quit:
+		return 0, false
+	case <-sp.quit:
+		return 0, false
+	}
+
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	return sp.activatedHeight, true
+}
+
+// block returns the block of the given height, which is queried once for the subscriptions
+// polling it at the same time and kept until all the subscriptions have passed it. The query
+// failed is not kept, so it is retried by the next subscription polling the height
+func (sp *SharedChainPoller) block(height uint64) (*types.BlockInfo, error) {
+	sp.mu.Lock()
+	query, ok := sp.blocks[height]
+	if !ok {
+		query = &blockQuery{done: make(chan struct{})}
+		sp.blocks[height] = query
+	}
+	sp.mu.Unlock()
+
+	if ok {
+		select {
+		case <-query.done:
+			return query.block, query.err
+		case <-sp.quit:
+			return nil, fmt.Errorf("the shared chain poller is stopped")
+		}
+	}
+
+	query.block, query.err = sp.blockWithRetry(height)
+	close(query.done)
+
+	sp.mu.Lock()
+	if query.err != nil {
+		delete(sp.blocks, height)
+	} else {
+		sp.pruneBlocks()
+	}
+	sp.mu.Unlock()
+
+	return query.block, query.err
+}
+
+// pruneBlocks deletes the blocks that all the subscriptions have passed, the caller
+// must hold the lock
+func (sp *SharedChainPoller) pruneBlocks() {
+	var (
+		lowest uint64
+		found  bool
+	)
+	for sub := range sp.subs {
+		if height := sub.nextHeight.Load(); !found || height < lowest {
+			lowest = height
+			found = true
+		}
+	}
+
+	for height, query := range sp.blocks {
+		select {
+		case <-query.done:
+		default:
+			// the query is in progress
+			continue
+		}
+		if !found || height < lowest {
+			delete(sp.blocks, height)
+		}
+	}
+}
+
+func (sp *SharedChainPoller) latestBlockWithRetry() (*types.BlockInfo, error) {
+	var (
+		latestBlock *types.BlockInfo
+		err         error
+	)
+
+	if err := retry.Do(func() error {
+		latestBlock, err = sp.cc.QueryBestBlock()
+		return err
+	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+		sp.logger.Debug(
+			"failed to query the consumer chain for the latest block",
+			zap.Uint("attempt", n+1),
+			zap.Uint("max_attempts", RtyAttNum),
+			zap.Error(err),
+		)
+	})); err != nil {
+		return nil, err
+	}
+
+	return latestBlock, nil
+}
+
+func (sp *SharedChainPoller) blockWithRetry(height uint64) (*types.BlockInfo, error) {
+	var (
+		block *types.BlockInfo
+		err   error
+	)
+
+	if err := retry.Do(func() error {
+		block, err = sp.cc.QueryBlock(height)
+		return err
+	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+		sp.logger.Debug(
+			"failed to query the consumer chain for the block",
+			zap.Uint("attempt", n+1),
+			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint64("height", height),
+			zap.Error(err),
+		)
+	})); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// pollerSubscription is the subscription of a finality-provider instance to the
+// SharedChainPoller, which polls and receives the blocks from its next height
+type pollerSubscription struct {
+	sp    *SharedChainPoller
+	onErr func(err error)
+	wg    sync.WaitGroup
+
+	isStarted     *atomic.Bool
+	nextHeight    *atomic.Uint64
+	blockInfoChan chan *types.BlockInfo
+	// wake wakes up the polling loop when the next height is skipped
+	wake chan struct{}
+	quit chan struct{}
+}
+
+var _ blockPoller = (*pollerSubscription)(nil)
+
+func (sub *pollerSubscription) Start(startHeight uint64) error {
+	if sub.isStarted.Swap(true) {
+		return fmt.Errorf("the poller subscription is already started")
+	}
+
+	if startHeight == 0 {
+		sub.isStarted.Store(false)
+		return fmt.Errorf("start height can't be 0")
+	}
+
+	latestBlock, err := sub.sp.latestBlockWithRetry()
+	if err != nil {
+		sub.isStarted.Store(false)
+		return fmt.Errorf("failed to query the latest block: %w", err)
+	}
+	// Allow the start height to be the next chain height
+	if startHeight > latestBlock.Height+1 {
+		sub.isStarted.Store(false)
+		return fmt.Errorf("start height %d is more than the next chain tip height %d", startHeight, latestBlock.Height+1)
+	}
+
+	sub.nextHeight.Store(startHeight)
+	sub.quit = make(chan struct{})
+	sub.sp.addSubscription(sub)
+
+	sub.wg.Add(1)
+	go sub.pollChain()
+
+	sub.sp.metrics.RecordPollerStartingHeight(startHeight)
+
+	return nil
+}
+
+func (sub *pollerSubscription) Stop() error {
+	if !sub.isStarted.Swap(false) {
+		return fmt.Errorf("the poller subscription has already stopped")
+	}
+
+	sub.sp.removeSubscription(sub)
+	close(sub.quit)
+	sub.wg.Wait()
+
+	return nil
+}
+
+func (sub *pollerSubscription) IsRunning() bool {
+	return sub.isStarted.Load()
+}
+
+func (sub *pollerSubscription) GetBlockInfoChan() <-chan *types.BlockInfo {
+	return sub.blockInfoChan
+}
+
+func (sub *pollerSubscription) SkipToHeight(height uint64) error {
+	if !sub.IsRunning() {
+		return fmt.Errorf("the chain poller is stopped")
+	}
+
+	nextHeight := sub.nextHeight.Load()
+	if height <= nextHeight {
+		return fmt.Errorf("the target height %d is not higher than the next height %d to retrieve",
+			height, nextHeight)
+	}
+
+	// drain blocks that can be skipped from blockInfoChan
+	for len(sub.blockInfoChan) > 0 {
+		block := <-sub.blockInfoChan
+		if block.Height+1 >= height {
+			break
+		}
+	}
+
+	sub.nextHeight.Store(height)
+
+	// wake up the polling loop without blocking
+	select {
+	case sub.wake <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (sub *pollerSubscription) NextHeight() uint64 {
+	return sub.nextHeight.Load()
+}
+
+func (sub *pollerSubscription) pollChain() {
+	defer sub.wg.Done()
+
+	activatedHeight, ok := sub.sp.getActivatedHeight(sub.quit)
+	if !ok {
+		return
+	}
+	// ensure that the next height is no lower than the activated height
+	if height := sub.nextHeight.Load(); height < activatedHeight {
+		sub.nextHeight.CompareAndSwap(height, activatedHeight)
+	}
+
+	var failedCycles uint32
+
+	for {
+		height := sub.nextHeight.Load()
+		block, err := sub.sp.block(height)
+		if err != nil {
+			failedCycles++
+			sub.sp.logger.Debug(
+				"failed to query the consumer chain for the block",
+				zap.Uint32("current_failures", failedCycles),
+				zap.Uint64("block_to_retrieve", height),
+				zap.Error(err),
+			)
+		} else {
+			failedCycles = 0
+			sub.sp.metrics.RecordLastPolledHeight(block.Height)
+
+			sub.sp.logger.Info("the shared poller retrieved the block from the consumer chain",
+				zap.Uint64("height", block.Height))
+
+			// Note: if the subscriber is too slow -- its buffer is full
+			// the delivery blocks this subscription only
+			if !sub.deliver(block) {
+				return
+			}
+		}
+
+		if failedCycles > maxFailedCycles {
+			// only the instance of the subscription is terminated
+			go sub.onErr(fmt.Errorf("the shared poller has reached the max failed cycles at height %d", height))
+			return
+		}
+
+		select {
+		case <-time.After(sub.sp.cfg.PollInterval):
+
+		case <-sub.wake:
+
+		case <-sub.quit:
+			return
+
+		case <-sub.sp.quit:
+			return
+		}
+	}
+}
+
+// deliver pushes the block to the subscription and bumps its next height unless it has
+// skipped heights meanwhile, and returns false if the subscription or the shared poller
+// is stopping
+func (sub *pollerSubscription) deliver(block *types.BlockInfo) bool {
+	select {
+	case sub.blockInfoChan <- block:
+		sub.nextHeight.CompareAndSwap(block.Height, block.Height+1)
+	case <-sub.quit:
+		return false
+	case <-sub.sp.quit:
+		return false
+	}
+
+	return true
+}
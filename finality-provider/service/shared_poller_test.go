@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
+	"github.com/babylonlabs-io/finality-provider/metrics"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+	"github.com/babylonlabs-io/finality-provider/testutil/mocks"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// FuzzSharedChainPoller_SlowSubscription tests that a subscription not receiving its
+// blocks does not hold back the other subscription polling from a different height
+func FuzzSharedChainPoller_SlowSubscription(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		startHeight := uint64(r.Int63n(100) + 1)
+		lagging := uint64(r.Int63n(10) + 1)
+		endHeight := startHeight + lagging + uint64(r.Int63n(10)+1)
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().Return(&types.BlockInfo{Height: endHeight}, nil).AnyTimes()
+		for i := startHeight; i <= endHeight; i++ {
+			mockClientController.EXPECT().QueryBlock(i).Return(&types.BlockInfo{Height: i}, nil).AnyTimes()
+		}
+		// the blocks above the chain tip are not found
+		mockClientController.EXPECT().QueryBlock(gomock.Any()).Return(nil, fmt.Errorf("block not found")).AnyTimes()
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		pollerCfg.BufferSize = 1
+		poller := service.NewSharedChainPoller(zap.NewNop(), &pollerCfg, mockClientController, m)
+		defer poller.Stop()
+
+		onErr := func(err error) {
+			t.Errorf("unexpected poller error: %v", err)
+		}
+
+		// the slow subscription never receives its blocks, so its buffer is full
+		slowSub := poller.Subscribe(onErr)
+		err := slowSub.Start(startHeight)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, slowSub.Stop())
+		}()
+
+		sub := poller.Subscribe(onErr)
+		err = sub.Start(startHeight + lagging)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, sub.Stop())
+		}()
+
+		for i := startHeight + lagging; i <= endHeight; i++ {
+			select {
+			case info := <-sub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+
+		// the slow subscription receives its blocks once it reads them
+		for i := startHeight; i <= endHeight; i++ {
+			select {
+			case info := <-slowSub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/avast/retry-go/v4"
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"go.uber.org/zap"
+
+	"github.com/babylonlabs-io/finality-provider/clientcontroller"
+	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
+)
+
+// recoveredState is the state of a finality provider recovered from the votes on the consumer chain
+type recoveredState struct {
+	// lastVotedHeight is the highest height voted by the finality provider among the
+	// scanned blocks, which is 0 if no vote is found
+	lastVotedHeight uint64
+	// lastProcessedHeight is the height up to which the blocks should be regarded as processed,
+	// which also covers the unfinalized blocks below the scan window that may have been voted
+	lastProcessedHeight uint64
+}
+
+// stateRecoveryScanWindow returns the scan window of the state recovery, which is the
+// default one for the config files written before the state recovery was introduced
+func stateRecoveryScanWindow(cfg *fpcfg.Config) uint64 {
+	if cfg.StateRecovery == nil {
+		return fpcfg.DefaultStateRecoveryConfig().ScanWindow
+	}
+
+	return cfg.StateRecovery.ScanWindow
+}
+
+// recoverStateFromChain scans the unfinalized blocks of the consumer chain from the tip downwards
+// for the highest height voted by the finality provider. At most scanWindow blocks are scanned,
+// and if the unfinalized blocks do not fit in the window, the ones below it are regarded as
+// processed since whether they have been voted is unknown. The finalized blocks and the blocks
+// up to the last processed height are never voted again, so they need not be scanned
+func recoverStateFromChain(
+	cc clientcontroller.ClientController,
+	fpPk *bbntypes.BIP340PubKey,
+	lastProcessedHeight uint64,
+	scanWindow uint64,
+	logger *zap.Logger,
+) (*recoveredState, error) {
+	var state *recoveredState
+
+	if err := retry.Do(func() error {
+		var err error
+		state, err = scanVotesFromTip(cc, fpPk, lastProcessedHeight, scanWindow)
+		return err
+	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+		logger.Debug(
+			"failed to query the consumer chain for the votes of the finality provider",
+			zap.String("pk", fpPk.MarshalHex()),
+			zap.Uint("attempt", n+1),
+			zap.Uint("max_attempts", RtyAttNum),
+			zap.Error(err),
+		)
+	})); err != nil {
+		return nil, fmt.Errorf("failed to recover the state of the finality provider %s from the consumer chain: %w",
+			fpPk.MarshalHex(), err)
+	}
+
+	return state, nil
+}
+
+func scanVotesFromTip(
+	cc clientcontroller.ClientController,
+	fpPk *bbntypes.BIP340PubKey,
+	lastProcessedHeight uint64,
+	scanWindow uint64,
+) (*recoveredState, error) {
+	tipBlock, err := cc.QueryBestBlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the tip block: %w", err)
+	}
+	finalizedBlocks, err := cc.QueryLatestFinalizedBlocks(1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the latest finalized block: %w", err)
+	}
+
+	lowestHeight := uint64(1)
+	if len(finalizedBlocks) != 0 {
+		lowestHeight = finalizedBlocks[0].Height + 1
+	}
+	if lastProcessedHeight >= lowestHeight {
+		lowestHeight = lastProcessedHeight + 1
+	}
+	if tipBlock.Height < lowestHeight {
+		// all the blocks are either finalized or processed
+		return &recoveredState{}, nil
+	}
+
+	state := &recoveredState{}
+	if tipBlock.Height-lowestHeight+1 > scanWindow {
+		lowestHeight = tipBlock.Height - scanWindow + 1
+		state.lastProcessedHeight = lowestHeight - 1
+	}
+
+	for height := tipBlock.Height; height >= lowestHeight; height-- {
+		voters, err := cc.QueryVotesAtHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, voter := range voters {
+			if voter.Equals(fpPk) {
+				state.lastVotedHeight = height
+				state.lastProcessedHeight = height
+				return state, nil
+			}
+		}
+	}
+
+	return state, nil
+}
@@ -0,0 +1,75 @@
+package service_test
+
+import (
+	"math/rand"
+	"testing"
+
+	bbntypes "github.com/babylonlabs-io/babylon/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/testutil"
+	"github.com/babylonlabs-io/finality-provider/testutil/mocks"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// FuzzRecoverFinalityProviderState tests recovering the last voted and processed heights of the
+// finality providers from their votes on the unfinalized blocks of the consumer chain
+func FuzzRecoverFinalityProviderState(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		finalizedHeight := uint64(r.Int63n(50) + 1)
+		tipHeight := finalizedHeight + uint64(r.Int63n(50)+10)
+		votedHeight := finalizedHeight + 1 + uint64(r.Int63n(int64(tipHeight-finalizedHeight)))
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock().
+			Return(&types.BlockInfo{Height: tipHeight, Hash: testutil.GenRandomByteArray(r, 32)}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(uint64(1)).
+			Return([]*types.BlockInfo{{Height: finalizedHeight, Hash: testutil.GenRandomByteArray(r, 32)}}, nil).AnyTimes()
+
+		app, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, finalizedHeight)
+		defer cleanUp()
+		votedFpPk := fpIns.GetBtcPkBIP340()
+
+		// the finality provider has voted at the voted height only, and no finalized
+		// block is expected to be scanned
+		mockClientController.EXPECT().QueryVotesAtHeight(gomock.Any()).DoAndReturn(func(height uint64) ([]bbntypes.BIP340PubKey, error) {
+			require.Greater(t, height, finalizedHeight)
+			if height == votedHeight {
+				return []bbntypes.BIP340PubKey{*votedFpPk}, nil
+			}
+			return nil, nil
+		}).AnyTimes()
+
+		// the local state is lost
+		require.Zero(t, fpIns.GetLastVotedHeight())
+		fp, err := app.RecoverFinalityProviderState(votedFpPk)
+		require.NoError(t, err)
+		require.Equal(t, votedHeight, fp.LastVotedHeight)
+		require.Equal(t, votedHeight, fp.LastProcessedHeight)
+
+		// the recovery never lowers the heights
+		err = app.GetFinalityProviderStore().SetFpLastVotedHeight(votedFpPk.MustToBTCPK(), tipHeight)
+		require.NoError(t, err)
+		fp, err = app.RecoverFinalityProviderState(votedFpPk)
+		require.NoError(t, err)
+		require.Equal(t, tipHeight, fp.LastVotedHeight)
+		require.Equal(t, tipHeight, fp.LastProcessedHeight)
+
+		// the unfinalized blocks below the scan window are regarded as processed
+		// for the finality provider that has no vote in the window
+		otherFp := testutil.GenStoredFinalityProvider(r, t, app, passphrase, hdPath, nil)
+		scanWindow := uint64(r.Int63n(int64(tipHeight-finalizedHeight-1)) + 1)
+		app.GetConfig().StateRecovery.ScanWindow = scanWindow
+		fp, err = app.RecoverFinalityProviderState(otherFp.GetBIP340BTCPK())
+		require.NoError(t, err)
+		require.Zero(t, fp.LastVotedHeight)
+		require.Equal(t, tipHeight-scanWindow, fp.LastProcessedHeight)
+	})
+}
@@ -17,4 +17,16 @@ var (
 
 	// ErrPubRandProofNotFound The finality provider we try update is not found in db
 	ErrPubRandProofNotFound = errors.New("public randomness proof not found")
+
+	// ErrCorruptedVoteJournalDb For some reason, db on disk representation have changed
+	ErrCorruptedVoteJournalDb = errors.New("vote journal db is corrupted")
+
+	// ErrVoteRecordNotFound The vote we try to get is not found in db
+	ErrVoteRecordNotFound = errors.New("vote record not found")
+
+	// ErrConflictingSignedBlock The finality provider has signed a different block at the height
+	ErrConflictingSignedBlock = errors.New("a conflicting block has been signed at the height")
+
+	// ErrSignedBlockNotFound The finality provider has not signed any block at the height
+	ErrSignedBlockNotFound = errors.New("signed block not found")
 )
@@ -37,6 +37,11 @@ func NewFinalityProviderStore(db kvdb.Backend) (*FinalityProviderStore, error) {
 func (s *FinalityProviderStore) initBuckets() error {
 	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
 		_, err := tx.CreateTopLevelBucket(finalityProviderBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(signedBlocksBucketName)
 		return err
 	})
 }
@@ -69,6 +74,13 @@ func (s *FinalityProviderStore) CreateFinalityProvider(
 	return s.createFinalityProviderInternal(fp)
 }
 
+// ImportFinalityProvider saves the given record of a finality provider that has been
+// registered elsewhere, e.g., rebuilt from the consumer chain, as it is. It fails with
+// ErrDuplicateFinalityProvider if the finality provider already exists
+func (s *FinalityProviderStore) ImportFinalityProvider(fp *proto.FinalityProvider) error {
+	return s.createFinalityProviderInternal(fp)
+}
+
 func (s *FinalityProviderStore) createFinalityProviderInternal(
 	fp *proto.FinalityProvider,
 ) error {
@@ -112,6 +124,17 @@ func (s *FinalityProviderStore) SetFpStatus(btcPk *btcec.PublicKey, status proto
 	return s.setFinalityProviderState(btcPk, setFpStatus)
 }
 
+// SetFpPaused sets whether the finality provider is paused, which submits no finality
+// signatures and is not started automatically
+func (s *FinalityProviderStore) SetFpPaused(btcPk *btcec.PublicKey, paused bool) error {
+	setFpPaused := func(fp *proto.FinalityProvider) error {
+		fp.Paused = paused
+		return nil
+	}
+
+	return s.setFinalityProviderState(btcPk, setFpPaused)
+}
+
 // UpdateFpStatusFromVotingPower based on the current voting power of the finality provider
 // updates the status, if it has some voting power, sets to active
 func (s *FinalityProviderStore) UpdateFpStatusFromVotingPower(
@@ -72,6 +72,20 @@ func FuzzFinalityProvidersStore(f *testing.F) {
 		actualFp, err := vs.GetFinalityProvider(fp.BtcPk)
 		require.NoError(t, err)
 		require.Equal(t, fp.BtcPk, actualFp.BtcPk)
+		require.False(t, actualFp.Paused)
+
+		// pause and resume the finality provider
+		err = vs.SetFpPaused(fp.BtcPk, true)
+		require.NoError(t, err)
+		actualFp, err = vs.GetFinalityProvider(fp.BtcPk)
+		require.NoError(t, err)
+		require.True(t, actualFp.Paused)
+		require.True(t, actualFp.ToFinalityProviderInfo().IsPaused)
+		err = vs.SetFpPaused(fp.BtcPk, false)
+		require.NoError(t, err)
+		actualFp, err = vs.GetFinalityProvider(fp.BtcPk)
+		require.NoError(t, err)
+		require.False(t, actualFp.Paused)
 
 		_, randomBtcPk, err := datagen.GenRandomBTCKeyPair(r)
 		require.NoError(t, err)
@@ -1,16 +1,23 @@
 package store
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
 	// mapping: pub_rand -> proof
+	// NOTE: the proofs were saved here before they were indexed by height, so this
+	// bucket is no longer written, and only read until it is emptied by the pruning
 	pubRandProofBucketName = []byte("pub_rand_proof")
+
+	// mapping: fp_btc_pk || chain_id -> height -> proof
+	pubRandProofByHeightBucketName = []byte("pub_rand_proof_by_height")
 )
 
 type PubRandProofStore struct {
@@ -29,44 +36,42 @@ func NewPubRandProofStore(db kvdb.Backend) (*PubRandProofStore, error) {
 
 func (s *PubRandProofStore) initBuckets() error {
 	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
-		_, err := tx.CreateTopLevelBucket(pubRandProofBucketName)
+		if _, err := tx.CreateTopLevelBucket(pubRandProofBucketName); err != nil {
+			return err
+		}
+		_, err := tx.CreateTopLevelBucket(pubRandProofByHeightBucketName)
 		return err
 	})
 }
 
+// AddPubRandProofList saves the proofs of the public randomness committed by the finality
+// provider on the given chain from the start height. The proofs already saved are skipped
 func (s *PubRandProofStore) AddPubRandProofList(
+	btcPk *btcec.PublicKey,
+	chainID []byte,
+	startHeight uint64,
 	pubRandList []*btcec.FieldVal,
 	proofList []*merkle.Proof,
 ) error {
-	if len(pubRandList) != len(proofList) {
-		return fmt.Errorf("the number of public randomness is not same as the number of proofs")
-	}
-
-	pubRandBytesList := [][]byte{}
-	proofBytesList := [][]byte{}
-	for i := range pubRandList {
-		pubRandBytes := *pubRandList[i].Bytes()
-		pubRandBytesList = append(pubRandBytesList, pubRandBytes[:])
-		proofBytes, err := proofList[i].ToProto().Marshal()
-		if err != nil {
-			return fmt.Errorf("invalid proof: %w", err)
-		}
-		proofBytesList = append(proofBytesList, proofBytes)
+	proofBytesList, err := marshalPubRandProofList(pubRandList, proofList)
+	if err != nil {
+		return err
 	}
 
 	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
-		bucket := tx.ReadWriteBucket(pubRandProofBucketName)
-		if bucket == nil {
-			return ErrCorruptedPubRandProofDb
+		chainBucket, err := createPubRandProofChainBucket(tx, btcPk, chainID)
+		if err != nil {
+			return err
 		}
 
-		for i := range pubRandBytesList {
+		for i := range proofBytesList {
+			key := heightKey(startHeight + uint64(i))
 			// skip if already committed
-			if bucket.Get(pubRandBytesList[i]) != nil {
+			if chainBucket.Get(key) != nil {
 				continue
 			}
 			// set to DB
-			if err := bucket.Put(pubRandBytesList[i], proofBytesList[i]); err != nil {
+			if err := chainBucket.Put(key, proofBytesList[i]); err != nil {
 				return err
 			}
 		}
@@ -75,56 +80,107 @@ func (s *PubRandProofStore) AddPubRandProofList(
 	})
 }
 
-func (s *PubRandProofStore) GetPubRandProof(pubRand *btcec.FieldVal) ([]byte, error) {
-	pubRandBytes := *pubRand.Bytes()
-	var proofBytes []byte
+// RepairPubRandProofList saves the proofs of the given public randomness that are missing
+// from the store or differ from the given ones, and returns the number of the proofs saved.
+// Unlike AddPubRandProofList, it overwrites the stored proofs, so the given proofs must have
+// been verified against the commitment on the consumer chain
+func (s *PubRandProofStore) RepairPubRandProofList(
+	btcPk *btcec.PublicKey,
+	chainID []byte,
+	startHeight uint64,
+	pubRandList []*btcec.FieldVal,
+	proofList []*merkle.Proof,
+) (uint64, error) {
+	proofBytesList, err := marshalPubRandProofList(pubRandList, proofList)
+	if err != nil {
+		return 0, err
+	}
 
-	err := s.db.View(func(tx kvdb.RTx) error {
-		bucket := tx.ReadBucket(pubRandProofBucketName)
-		if bucket == nil {
-			return ErrCorruptedPubRandProofDb
+	var numRepaired uint64
+	err = kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		// reset as the batch may be retried
+		numRepaired = 0
+
+		chainBucket, err := createPubRandProofChainBucket(tx, btcPk, chainID)
+		if err != nil {
+			return err
 		}
 
-		proofBytes = bucket.Get(pubRandBytes[:])
-		if proofBytes == nil {
-			return ErrPubRandProofNotFound
+		for i := range proofBytesList {
+			key := heightKey(startHeight + uint64(i))
+			if bytes.Equal(chainBucket.Get(key), proofBytesList[i]) {
+				continue
+			}
+			if err := chainBucket.Put(key, proofBytesList[i]); err != nil {
+				return err
+			}
+			numRepaired++
 		}
 
 		return nil
-	}, func() {})
-
+	})
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return proofBytes, nil
+	return numRepaired, nil
 }
 
-func (s *PubRandProofStore) GetPubRandProofList(pubRandList []*btcec.FieldVal) ([][]byte, error) {
-	pubRandBytesList := [][]byte{}
-	for i := range pubRandList {
-		pubRandBytes := *pubRandList[i].Bytes()
-		pubRandBytesList = append(pubRandBytesList, pubRandBytes[:])
+// GetPubRandProof returns the proof of the public randomness committed by the
+// finality provider on the given chain at the given height
+func (s *PubRandProofStore) GetPubRandProof(
+	btcPk *btcec.PublicKey,
+	chainID []byte,
+	height uint64,
+	pubRand *btcec.FieldVal,
+) ([]byte, error) {
+	proofBytesList, err := s.GetPubRandProofList(btcPk, chainID, height, []*btcec.FieldVal{pubRand})
+	if err != nil {
+		return nil, err
 	}
 
+	return proofBytesList[0], nil
+}
+
+// GetPubRandProofList returns the proofs of the public randomness committed by the
+// finality provider on the given chain from the start height
+func (s *PubRandProofStore) GetPubRandProofList(
+	btcPk *btcec.PublicKey,
+	chainID []byte,
+	startHeight uint64,
+	pubRandList []*btcec.FieldVal,
+) ([][]byte, error) {
 	proofBytesList := [][]byte{}
 
 	err := s.db.View(func(tx kvdb.RTx) error {
-		bucket := tx.ReadBucket(pubRandProofBucketName)
-		if bucket == nil {
+		bucket := tx.ReadBucket(pubRandProofByHeightBucketName)
+		legacyBucket := tx.ReadBucket(pubRandProofBucketName)
+		if bucket == nil || legacyBucket == nil {
 			return ErrCorruptedPubRandProofDb
 		}
 
-		for i := range pubRandBytesList {
-			proofBytes := bucket.Get(pubRandBytesList[i])
+		chainBucket := bucket.NestedReadBucket(pubRandProofChainKey(btcPk, chainID))
+
+		for i := range pubRandList {
+			var proofBytes []byte
+			if chainBucket != nil {
+				proofBytes = chainBucket.Get(heightKey(startHeight + uint64(i)))
+			}
+			if proofBytes == nil {
+				// fall back to the proofs saved before they were indexed by height
+				pubRandBytes := *pubRandList[i].Bytes()
+				proofBytes = legacyBucket.Get(pubRandBytes[:])
+			}
 			if proofBytes == nil {
 				return ErrPubRandProofNotFound
 			}
-			proofBytesList = append(proofBytesList, proofBytes)
+			proofBytesList = append(proofBytesList, append([]byte{}, proofBytes...))
 		}
 
 		return nil
-	}, func() {})
+	}, func() {
+		proofBytesList = [][]byte{}
+	})
 
 	if err != nil {
 		return nil, err
@@ -133,4 +189,154 @@ func (s *PubRandProofStore) GetPubRandProofList(pubRandList []*btcec.FieldVal) (
 	return proofBytesList, nil
 }
 
-// TODO: delete function?
+// CountPubRandProofs returns the number of the proofs indexed by height of the public
+// randomness committed by the finality provider on the given chain from the start height
+// to the end height, inclusive
+func (s *PubRandProofStore) CountPubRandProofs(btcPk *btcec.PublicKey, chainID []byte, startHeight, endHeight uint64) (uint64, error) {
+	var count uint64
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(pubRandProofByHeightBucketName)
+		if bucket == nil {
+			return ErrCorruptedPubRandProofDb
+		}
+
+		chainBucket := bucket.NestedReadBucket(pubRandProofChainKey(btcPk, chainID))
+		if chainBucket == nil {
+			return nil
+		}
+
+		endKey := heightKey(endHeight)
+		c := chainBucket.ReadCursor()
+		for k, _ := c.Seek(heightKey(startHeight)); k != nil && bytes.Compare(k, endKey) <= 0; k, _ = c.Next() {
+			count++
+		}
+
+		return nil
+	}, func() {
+		count = 0
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// PrunePubRandProofs deletes the proofs of the public randomness committed by the finality
+// provider on the given chain below the given height, and returns the number of the proofs
+// deleted. The proofs saved before they were indexed by height are deleted by
+// DeleteLegacyPubRandProofs instead
+func (s *PubRandProofStore) PrunePubRandProofs(btcPk *btcec.PublicKey, chainID []byte, belowHeight uint64) (uint64, error) {
+	var pruned uint64
+
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		pruned = 0
+
+		bucket := tx.ReadWriteBucket(pubRandProofByHeightBucketName)
+		if bucket == nil {
+			return ErrCorruptedPubRandProofDb
+		}
+
+		chainBucket := bucket.NestedReadWriteBucket(pubRandProofChainKey(btcPk, chainID))
+		if chainBucket == nil {
+			return nil
+		}
+
+		var heights [][]byte
+		belowKey := heightKey(belowHeight)
+		c := chainBucket.ReadCursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k, belowKey) < 0; k, _ = c.Next() {
+			heights = append(heights, append([]byte(nil), k...))
+		}
+
+		for _, k := range heights {
+			if err := chainBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		pruned = uint64(len(heights))
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return pruned, nil
+}
+
+// DeleteLegacyPubRandProofs deletes all the proofs saved before they were indexed by height,
+// and returns the number of the proofs deleted. The proofs needed for voting are regenerated
+// into the height index by the repair of the public randomness proofs
+func (s *PubRandProofStore) DeleteLegacyPubRandProofs() (uint64, error) {
+	var deleted uint64
+
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		deleted = 0
+
+		legacyBucket := tx.ReadWriteBucket(pubRandProofBucketName)
+		if legacyBucket == nil {
+			return ErrCorruptedPubRandProofDb
+		}
+
+		if err := legacyBucket.ForEach(func(_, _ []byte) error {
+			deleted++
+			return nil
+		}); err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return nil
+		}
+
+		if err := tx.DeleteTopLevelBucket(pubRandProofBucketName); err != nil {
+			return err
+		}
+		_, err := tx.CreateTopLevelBucket(pubRandProofBucketName)
+		return err
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+func createPubRandProofChainBucket(tx kvdb.RwTx, btcPk *btcec.PublicKey, chainID []byte) (kvdb.RwBucket, error) {
+	bucket := tx.ReadWriteBucket(pubRandProofByHeightBucketName)
+	if bucket == nil {
+		return nil, ErrCorruptedPubRandProofDb
+	}
+
+	return bucket.CreateBucketIfNotExists(pubRandProofChainKey(btcPk, chainID))
+}
+
+// pubRandProofChainKey returns the key of the proofs of the finality provider on the chain,
+// which is never empty and unambiguous as the serialized public key has a fixed length
+func pubRandProofChainKey(btcPk *btcec.PublicKey, chainID []byte) []byte {
+	return append(schnorr.SerializePubKey(btcPk), chainID...)
+}
+
+func marshalPubRandProofList(
+	pubRandList []*btcec.FieldVal,
+	proofList []*merkle.Proof,
+) ([][]byte, error) {
+	if len(pubRandList) != len(proofList) {
+		return nil, fmt.Errorf("the number of public randomness is not same as the number of proofs")
+	}
+
+	proofBytesList := [][]byte{}
+	for i := range proofList {
+		proofBytes, err := proofList[i].ToProto().Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof: %w", err)
+		}
+		proofBytesList = append(proofBytesList, proofBytes)
+	}
+
+	return proofBytesList, nil
+}
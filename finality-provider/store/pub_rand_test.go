@@ -0,0 +1,205 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	fpstore "github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+	"github.com/babylonlabs-io/finality-provider/types"
+)
+
+// FuzzPubRandProofStore tests saving, repairing, counting and pruning the public randomness
+// proofs indexed by height, and compacting the db afterwards
+func FuzzPubRandProofStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		db, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		ps, err := fpstore.NewPubRandProofStore(db)
+		require.NoError(t, err)
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, otherBtcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		chainID := []byte(datagen.GenRandomHexStr(r, 10))
+		otherChainID := []byte(datagen.GenRandomHexStr(r, 10))
+
+		startHeight := uint64(r.Int63n(100) + 1)
+		numPubRand := uint64(r.Int63n(100) + 10)
+		pubRandList := make([]*btcec.FieldVal, 0, numPubRand)
+		for i := uint64(0); i < numPubRand; i++ {
+			var pubRand btcec.FieldVal
+			pubRand.SetByteSlice(datagen.GenRandomByteArray(r, 32))
+			pubRandList = append(pubRandList, &pubRand)
+		}
+		_, proofList := types.GetPubRandCommitAndProofs(pubRandList)
+		expectedProofBytesList := make([][]byte, 0, numPubRand)
+		for _, proof := range proofList {
+			proofBytes, err := proof.ToProto().Marshal()
+			require.NoError(t, err)
+			expectedProofBytesList = append(expectedProofBytesList, proofBytes)
+		}
+
+		err = ps.AddPubRandProofList(btcPk, chainID, startHeight, pubRandList, proofList)
+		require.NoError(t, err)
+
+		proofBytesList, err := ps.GetPubRandProofList(btcPk, chainID, startHeight, pubRandList)
+		require.NoError(t, err)
+		require.Equal(t, expectedProofBytesList, proofBytesList)
+		i := r.Int63n(int64(numPubRand))
+		proofBytes, err := ps.GetPubRandProof(btcPk, chainID, startHeight+uint64(i), pubRandList[i])
+		require.NoError(t, err)
+		require.Equal(t, expectedProofBytesList[i], proofBytes)
+
+		// the proofs are separated by finality provider and chain
+		_, err = ps.GetPubRandProof(otherBtcPk, chainID, startHeight, pubRandList[0])
+		require.ErrorIs(t, err, fpstore.ErrPubRandProofNotFound)
+		_, err = ps.GetPubRandProof(btcPk, otherChainID, startHeight, pubRandList[0])
+		require.ErrorIs(t, err, fpstore.ErrPubRandProofNotFound)
+		_, err = ps.GetPubRandProof(btcPk, chainID, startHeight+numPubRand, pubRandList[0])
+		require.ErrorIs(t, err, fpstore.ErrPubRandProofNotFound)
+
+		// the repair only saves the proofs that are missing
+		numRepaired, err := ps.RepairPubRandProofList(btcPk, chainID, startHeight, pubRandList, proofList)
+		require.NoError(t, err)
+		require.Zero(t, numRepaired)
+		numRepaired, err = ps.RepairPubRandProofList(otherBtcPk, chainID, startHeight, pubRandList, proofList)
+		require.NoError(t, err)
+		require.Equal(t, numPubRand, numRepaired)
+
+		// prune the proofs below a random height
+		pruneHeight := startHeight + uint64(r.Int63n(int64(numPubRand)))
+		numPruned, err := ps.PrunePubRandProofs(btcPk, chainID, pruneHeight)
+		require.NoError(t, err)
+		require.Equal(t, pruneHeight-startHeight, numPruned)
+		numPruned, err = ps.PrunePubRandProofs(btcPk, chainID, pruneHeight)
+		require.NoError(t, err)
+		require.Zero(t, numPruned)
+		numPruned, err = ps.PrunePubRandProofs(btcPk, otherChainID, startHeight+numPubRand)
+		require.NoError(t, err)
+		require.Zero(t, numPruned)
+
+		if pruneHeight > startHeight {
+			_, err = ps.GetPubRandProof(btcPk, chainID, pruneHeight-1, pubRandList[pruneHeight-1-startHeight])
+			require.ErrorIs(t, err, fpstore.ErrPubRandProofNotFound)
+		}
+		// only the remaining proofs are counted
+		numStored, err := ps.CountPubRandProofs(btcPk, chainID, startHeight, startHeight+numPubRand-1)
+		require.NoError(t, err)
+		require.Equal(t, startHeight+numPubRand-pruneHeight, numStored)
+		numStored, err = ps.CountPubRandProofs(btcPk, otherChainID, startHeight, startHeight+numPubRand-1)
+		require.NoError(t, err)
+		require.Zero(t, numStored)
+		// the proofs of the other finality provider are not pruned
+		_, err = ps.GetPubRandProof(otherBtcPk, chainID, startHeight, pubRandList[0])
+		require.NoError(t, err)
+
+		// the remaining proofs are kept after compacting the db
+		require.NoError(t, db.Close())
+		require.NoError(t, cfg.Compact())
+		db, err = cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		ps, err = fpstore.NewPubRandProofStore(db)
+		require.NoError(t, err)
+
+		offset := pruneHeight - startHeight
+		proofBytesList, err = ps.GetPubRandProofList(btcPk, chainID, pruneHeight, pubRandList[offset:])
+		require.NoError(t, err)
+		require.Equal(t, expectedProofBytesList[offset:], proofBytesList)
+	})
+}
+
+// FuzzPubRandProofStoreUpgrade tests reading the public randomness proofs saved before they
+// were indexed by height, and deleting them once the ones needed are indexed by height
+func FuzzPubRandProofStoreUpgrade(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		db, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		ps, err := fpstore.NewPubRandProofStore(db)
+		require.NoError(t, err)
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		chainID := []byte(datagen.GenRandomHexStr(r, 10))
+
+		startHeight := uint64(r.Int63n(100) + 1)
+		numPubRand := uint64(r.Int63n(100) + 10)
+		pubRandList := make([]*btcec.FieldVal, 0, numPubRand)
+		for i := uint64(0); i < numPubRand; i++ {
+			var pubRand btcec.FieldVal
+			pubRand.SetByteSlice(datagen.GenRandomByteArray(r, 32))
+			pubRandList = append(pubRandList, &pubRand)
+		}
+		_, proofList := types.GetPubRandCommitAndProofs(pubRandList)
+		expectedProofBytesList := make([][]byte, 0, numPubRand)
+		for _, proof := range proofList {
+			proofBytes, err := proof.ToProto().Marshal()
+			require.NoError(t, err)
+			expectedProofBytesList = append(expectedProofBytesList, proofBytes)
+		}
+
+		// save the proofs keyed by the public randomness as the previous versions did
+		err = kvdb.Update(db, func(tx kvdb.RwTx) error {
+			legacyBucket := tx.ReadWriteBucket([]byte("pub_rand_proof"))
+			for i, pubRand := range pubRandList {
+				pubRandBytes := *pubRand.Bytes()
+				if err := legacyBucket.Put(pubRandBytes[:], expectedProofBytesList[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, func() {})
+		require.NoError(t, err)
+
+		// the legacy proofs are read, but not counted as indexed by height
+		proofBytesList, err := ps.GetPubRandProofList(btcPk, chainID, startHeight, pubRandList)
+		require.NoError(t, err)
+		require.Equal(t, expectedProofBytesList, proofBytesList)
+		numStored, err := ps.CountPubRandProofs(btcPk, chainID, startHeight, startHeight+numPubRand-1)
+		require.NoError(t, err)
+		require.Zero(t, numStored)
+
+		// the repair indexes the proofs needed by height
+		offset := uint64(r.Int63n(int64(numPubRand)))
+		numRepaired, err := ps.RepairPubRandProofList(btcPk, chainID, startHeight+offset, pubRandList[offset:], proofList[offset:])
+		require.NoError(t, err)
+		require.Equal(t, numPubRand-offset, numRepaired)
+
+		// the legacy proofs are deleted, while the ones indexed by height are kept
+		numDeleted, err := ps.DeleteLegacyPubRandProofs()
+		require.NoError(t, err)
+		require.Equal(t, numPubRand, numDeleted)
+		numDeleted, err = ps.DeleteLegacyPubRandProofs()
+		require.NoError(t, err)
+		require.Zero(t, numDeleted)
+
+		if offset > 0 {
+			_, err = ps.GetPubRandProof(btcPk, chainID, startHeight, pubRandList[0])
+			require.ErrorIs(t, err, fpstore.ErrPubRandProofNotFound)
+		}
+		proofBytesList, err = ps.GetPubRandProofList(btcPk, chainID, startHeight+offset, pubRandList[offset:])
+		require.NoError(t, err)
+		require.Equal(t, expectedProofBytesList[offset:], proofBytesList)
+	})
+}
@@ -0,0 +1,140 @@
+package store
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// mapping: fp_btc_pk -> height -> signed block hash
+	signedBlocksBucketName = []byte("signed_blocks")
+)
+
+// SaveSignedBlocks records the hashes of the blocks the finality provider is about to sign
+// at the given heights in a single transaction, which must be done before requesting the
+// signatures. Heights that already have a record of the same hash are skipped, so that the
+// signing can be retried. It fails with ErrConflictingSignedBlock if a different hash has
+// been recorded at any of the heights, in which case nothing is saved
+func (s *FinalityProviderStore) SaveSignedBlocks(btcPk *btcec.PublicKey, heights []uint64, hashes [][]byte) error {
+	if len(heights) != len(hashes) {
+		return fmt.Errorf("the number of heights %d and block hashes %d mismatch", len(heights), len(hashes))
+	}
+	for i, hash := range hashes {
+		if len(hash) == 0 {
+			return fmt.Errorf("cannot save empty block hash at height %d", heights[i])
+		}
+	}
+
+	pkBytes := schnorr.SerializePubKey(btcPk)
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(signedBlocksBucketName)
+		if bucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		fpBucket, err := bucket.CreateBucketIfNotExists(pkBytes)
+		if err != nil {
+			return err
+		}
+
+		for i, height := range heights {
+			key := heightKey(height)
+			if existing := fpBucket.Get(key); existing != nil {
+				if !bytes.Equal(existing, hashes[i]) {
+					return fmt.Errorf("%w: height %d, signed hash %s, requested hash %s",
+						ErrConflictingSignedBlock, height, hex.EncodeToString(existing), hex.EncodeToString(hashes[i]))
+				}
+				continue
+			}
+
+			if err := fpBucket.Put(key, hashes[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetSignedBlockHash returns the hash of the block signed by the finality provider at the
+// given height. It fails with ErrSignedBlockNotFound if no block is signed at the height
+func (s *FinalityProviderStore) GetSignedBlockHash(btcPk *btcec.PublicKey, height uint64) ([]byte, error) {
+	pkBytes := schnorr.SerializePubKey(btcPk)
+	var hash []byte
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(signedBlocksBucketName)
+		if bucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		fpBucket := bucket.NestedReadBucket(pkBytes)
+		if fpBucket == nil {
+			return ErrSignedBlockNotFound
+		}
+
+		v := fpBucket.Get(heightKey(height))
+		if v == nil {
+			return ErrSignedBlockNotFound
+		}
+		hash = append([]byte{}, v...)
+
+		return nil
+	}, func() {})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
+
+// PruneSignedBlocks deletes the records of the blocks signed by the finality provider below
+// the given height, and returns the number of the deleted records. The heights must be
+// finalized, so that no block can be signed at them again
+func (s *FinalityProviderStore) PruneSignedBlocks(btcPk *btcec.PublicKey, belowHeight uint64) (uint64, error) {
+	pkBytes := schnorr.SerializePubKey(btcPk)
+	var pruned uint64
+
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		pruned = 0
+
+		bucket := tx.ReadWriteBucket(signedBlocksBucketName)
+		if bucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		fpBucket := bucket.NestedReadWriteBucket(pkBytes)
+		if fpBucket == nil {
+			return nil
+		}
+
+		var heights [][]byte
+		belowKey := heightKey(belowHeight)
+		c := fpBucket.ReadCursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k, belowKey) < 0; k, _ = c.Next() {
+			heights = append(heights, append([]byte(nil), k...))
+		}
+
+		for _, k := range heights {
+			if err := fpBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		pruned = uint64(len(heights))
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return pruned, nil
+}
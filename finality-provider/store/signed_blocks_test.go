@@ -0,0 +1,96 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	fpstore "github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzSignedBlocks tests that the signed blocks are recorded idempotently and that
+// signing a conflicting block at a recorded height is refused atomically, and the
+// records are pruned below a given height
+func FuzzSignedBlocks(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		db, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		fps, err := fpstore.NewFinalityProviderStore(db)
+		require.NoError(t, err)
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, otherBtcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+
+		startHeight := uint64(r.Int63n(100) + 1)
+		numBlocks := int(r.Int63n(10) + 2)
+		heights := make([]uint64, 0, numBlocks)
+		hashes := make([][]byte, 0, numBlocks)
+		for i := 0; i < numBlocks; i++ {
+			heights = append(heights, startHeight+uint64(i))
+			hashes = append(hashes, datagen.GenRandomByteArray(r, 32))
+		}
+		err = fps.SaveSignedBlocks(btcPk, heights, hashes)
+		require.NoError(t, err)
+
+		// signing the same blocks again is allowed
+		err = fps.SaveSignedBlocks(btcPk, heights[:1], hashes[:1])
+		require.NoError(t, err)
+		hash, err := fps.GetSignedBlockHash(btcPk, startHeight)
+		require.NoError(t, err)
+		require.Equal(t, hashes[0], hash)
+
+		// signing a conflicting block is refused, and the new block in the same
+		// request is not recorded either
+		newHeight := startHeight + uint64(numBlocks)
+		conflictIdx := r.Intn(numBlocks)
+		err = fps.SaveSignedBlocks(
+			btcPk,
+			[]uint64{newHeight, heights[conflictIdx]},
+			[][]byte{datagen.GenRandomByteArray(r, 32), datagen.GenRandomByteArray(r, 32)},
+		)
+		require.ErrorIs(t, err, fpstore.ErrConflictingSignedBlock)
+		_, err = fps.GetSignedBlockHash(btcPk, newHeight)
+		require.ErrorIs(t, err, fpstore.ErrSignedBlockNotFound)
+		hash, err = fps.GetSignedBlockHash(btcPk, heights[conflictIdx])
+		require.NoError(t, err)
+		require.Equal(t, hashes[conflictIdx], hash)
+
+		// the records of the other finality providers are independent
+		_, err = fps.GetSignedBlockHash(otherBtcPk, startHeight)
+		require.ErrorIs(t, err, fpstore.ErrSignedBlockNotFound)
+		err = fps.SaveSignedBlocks(otherBtcPk, heights[:1], [][]byte{datagen.GenRandomByteArray(r, 32)})
+		require.NoError(t, err)
+
+		// prune the records below a random height
+		pruneHeight := startHeight + uint64(r.Intn(numBlocks))
+		pruned, err := fps.PruneSignedBlocks(btcPk, pruneHeight)
+		require.NoError(t, err)
+		require.Equal(t, pruneHeight-startHeight, pruned)
+		pruned, err = fps.PruneSignedBlocks(btcPk, pruneHeight)
+		require.NoError(t, err)
+		require.Zero(t, pruned)
+		if pruneHeight > startHeight {
+			_, err = fps.GetSignedBlockHash(btcPk, pruneHeight-1)
+			require.ErrorIs(t, err, fpstore.ErrSignedBlockNotFound)
+		}
+		hash, err = fps.GetSignedBlockHash(btcPk, pruneHeight)
+		require.NoError(t, err)
+		require.Equal(t, hashes[pruneHeight-startHeight], hash)
+		// the records of the other finality providers are not pruned
+		_, err = fps.GetSignedBlockHash(otherBtcPk, startHeight)
+		require.NoError(t, err)
+	})
+}
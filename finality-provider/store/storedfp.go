@@ -23,6 +23,7 @@ type StoredFinalityProvider struct {
 	LastVotedHeight     uint64
 	LastProcessedHeight uint64
 	Status              proto.FinalityProviderStatus
+	Paused              bool
 }
 
 func protoFpToStoredFinalityProvider(fp *proto.FinalityProvider) (*StoredFinalityProvider, error) {
@@ -54,6 +55,7 @@ func protoFpToStoredFinalityProvider(fp *proto.FinalityProvider) (*StoredFinalit
 		LastVotedHeight:     fp.LastVotedHeight,
 		LastProcessedHeight: fp.LastProcessedHeight,
 		Status:              fp.Status,
+		Paused:              fp.Paused,
 	}, nil
 }
 
@@ -75,6 +77,7 @@ func (sfp *StoredFinalityProvider) ToFinalityProviderInfo() *proto.FinalityProvi
 		Commission:      sfp.Commission.String(),
 		LastVotedHeight: sfp.LastVotedHeight,
 		Status:          sfp.Status.String(),
+		IsPaused:        sfp.Paused,
 	}
 }
 
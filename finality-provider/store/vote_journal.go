@@ -0,0 +1,262 @@
+package store
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightningnetwork/lnd/kvdb"
+	pm "google.golang.org/protobuf/proto"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+)
+
+var (
+	// mapping: fp_btc_pk -> height -> vote record
+	voteJournalBucketName = []byte("vote_journal")
+)
+
+// VoteJournalStore records the finality signatures submitted by the finality providers,
+// which are indexed by the heights of the voted blocks
+type VoteJournalStore struct {
+	db kvdb.Backend
+}
+
+// NewVoteJournalStore returns a new store backed by db
+func NewVoteJournalStore(db kvdb.Backend) (*VoteJournalStore, error) {
+	store := &VoteJournalStore{db}
+	if err := store.initBuckets(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *VoteJournalStore) initBuckets() error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(voteJournalBucketName)
+		return err
+	})
+}
+
+// AddVoteRecords records the votes of the finality provider, which overwrite the
+// votes recorded at the same heights
+func (s *VoteJournalStore) AddVoteRecords(btcPk *btcec.PublicKey, records []*proto.VoteRecord) error {
+	pkBytes := schnorr.SerializePubKey(btcPk)
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(voteJournalBucketName)
+		if bucket == nil {
+			return ErrCorruptedVoteJournalDb
+		}
+
+		fpBucket, err := bucket.CreateBucketIfNotExists(pkBytes)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			recordBytes, err := pm.Marshal(record)
+			if err != nil {
+				return err
+			}
+			if err := fpBucket.Put(heightKey(record.Height), recordBytes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetVoteRecord returns the vote of the finality provider at the given height
+func (s *VoteJournalStore) GetVoteRecord(btcPk *btcec.PublicKey, height uint64) (*proto.VoteRecord, error) {
+	pkBytes := schnorr.SerializePubKey(btcPk)
+	var record *proto.VoteRecord
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(voteJournalBucketName)
+		if bucket == nil {
+			return ErrCorruptedVoteJournalDb
+		}
+
+		fpBucket := bucket.NestedReadBucket(pkBytes)
+		if fpBucket == nil {
+			return ErrVoteRecordNotFound
+		}
+
+		recordBytes := fpBucket.Get(heightKey(height))
+		if recordBytes == nil {
+			return ErrVoteRecordNotFound
+		}
+
+		var err error
+		record, err = unmarshalVoteRecord(recordBytes)
+
+		return err
+	}, func() {})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// QueryVoteRecords returns at most limit votes of the finality provider from the start height
+// in the ascending order of height, or in the descending order if reverse is set. The start
+// height of 0 starts from the lowest height, or the highest height if reverse is set. It also
+// returns the start height of the next page, which is 0 if there are no more votes
+func (s *VoteJournalStore) QueryVoteRecords(
+	btcPk *btcec.PublicKey,
+	startHeight uint64,
+	limit uint32,
+	reverse bool,
+) ([]*proto.VoteRecord, uint64, error) {
+	pkBytes := schnorr.SerializePubKey(btcPk)
+	var (
+		records    []*proto.VoteRecord
+		nextHeight uint64
+	)
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(voteJournalBucketName)
+		if bucket == nil {
+			return ErrCorruptedVoteJournalDb
+		}
+
+		fpBucket := bucket.NestedReadBucket(pkBytes)
+		if fpBucket == nil {
+			return nil
+		}
+
+		c := fpBucket.ReadCursor()
+		var k, v []byte
+		switch {
+		case startHeight == 0 && reverse:
+			k, v = c.Last()
+		case startHeight == 0:
+			k, v = c.First()
+		default:
+			k, v = c.Seek(heightKey(startHeight))
+			// the seek lands on the lowest height not lower than the start height,
+			// while the reverse query starts from the highest height not higher than it
+			if reverse {
+				if k == nil {
+					k, v = c.Last()
+				} else if binary.BigEndian.Uint64(k) > startHeight {
+					k, v = c.Prev()
+				}
+			}
+		}
+
+		for ; k != nil; k, v = next(c, reverse) {
+			if uint32(len(records)) == limit {
+				nextHeight = binary.BigEndian.Uint64(k)
+				return nil
+			}
+
+			record, err := unmarshalVoteRecord(v)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+
+		return nil
+	}, func() {
+		records = nil
+		nextHeight = 0
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return records, nextHeight, nil
+}
+
+// PruneVoteRecords deletes the votes submitted before the given time, and returns the
+// number of the deleted votes. The timestamp of every vote is checked, as the votes are
+// not always submitted in the ascending order of height, e.g., a vote overwritten by a
+// later submission at the same height
+func (s *VoteJournalStore) PruneVoteRecords(before time.Time) (uint64, error) {
+	var pruned uint64
+
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		pruned = 0
+
+		bucket := tx.ReadWriteBucket(voteJournalBucketName)
+		if bucket == nil {
+			return ErrCorruptedVoteJournalDb
+		}
+
+		var fpPks [][]byte
+		if err := bucket.ForEach(func(k, v []byte) error {
+			// the nested buckets have nil values
+			if v == nil {
+				fpPks = append(fpPks, k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, fpPk := range fpPks {
+			fpBucket := bucket.NestedReadWriteBucket(fpPk)
+
+			var heights [][]byte
+			c := fpBucket.ReadCursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				record, err := unmarshalVoteRecord(v)
+				if err != nil {
+					return err
+				}
+				if record.Timestamp < before.Unix() {
+					heights = append(heights, append([]byte(nil), k...))
+				}
+			}
+
+			for _, k := range heights {
+				if err := fpBucket.Delete(k); err != nil {
+					return err
+				}
+			}
+			pruned += uint64(len(heights))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return pruned, nil
+}
+
+func next(c kvdb.RCursor, reverse bool) ([]byte, []byte) {
+	if reverse {
+		return c.Prev()
+	}
+
+	return c.Next()
+}
+
+// heightKey encodes the height in big endian so that the votes are sorted by height
+func heightKey(height uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, height)
+
+	return key
+}
+
+func unmarshalVoteRecord(recordBytes []byte) (*proto.VoteRecord, error) {
+	var record proto.VoteRecord
+	if err := pm.Unmarshal(recordBytes, &record); err != nil {
+		return nil, ErrCorruptedVoteJournalDb
+	}
+
+	return &record, nil
+}
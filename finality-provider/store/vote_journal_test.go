@@ -0,0 +1,131 @@
+package store_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/finality-provider/config"
+	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
+	fpstore "github.com/babylonlabs-io/finality-provider/finality-provider/store"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// FuzzVoteJournalStore tests recording, paginating and pruning the votes
+func FuzzVoteJournalStore(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		cfg := config.DefaultDBConfigWithHomePath(t.TempDir())
+		db, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		vs, err := fpstore.NewVoteJournalStore(db)
+		require.NoError(t, err)
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, otherBtcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+
+		// record the votes at consecutive heights, the first half of which are old
+		startHeight := uint64(r.Int63n(100) + 1)
+		numVotes := uint64(r.Int63n(50) + 10)
+		now := time.Now()
+		var records []*proto.VoteRecord
+		for i := uint64(0); i < numVotes; i++ {
+			timestamp := now
+			if i < numVotes/2 {
+				timestamp = now.Add(-2 * time.Hour)
+			}
+			records = append(records, &proto.VoteRecord{
+				Height:    startHeight + i,
+				BlockHash: datagen.GenRandomByteArray(r, 32),
+				TxHash:    datagen.GenRandomHexStr(r, 32),
+				Timestamp: timestamp.Unix(),
+				FastSync:  r.Intn(2) == 0,
+			})
+		}
+		err = vs.AddVoteRecords(btcPk, records)
+		require.NoError(t, err)
+
+		vote, err := vs.GetVoteRecord(btcPk, startHeight)
+		require.NoError(t, err)
+		require.Equal(t, records[0].BlockHash, vote.BlockHash)
+		_, err = vs.GetVoteRecord(btcPk, startHeight+numVotes)
+		require.ErrorIs(t, err, fpstore.ErrVoteRecordNotFound)
+		_, err = vs.GetVoteRecord(otherBtcPk, startHeight)
+		require.ErrorIs(t, err, fpstore.ErrVoteRecordNotFound)
+
+		// paginate the votes in both orders
+		limit := uint32(r.Int63n(int64(numVotes)) + 1)
+		for _, reverse := range []bool{false, true} {
+			var (
+				votes      []*proto.VoteRecord
+				nextHeight uint64
+			)
+			for {
+				page, next, err := vs.QueryVoteRecords(btcPk, nextHeight, limit, reverse)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(page), int(limit))
+				votes = append(votes, page...)
+				if next == 0 {
+					break
+				}
+				nextHeight = next
+			}
+			require.Len(t, votes, int(numVotes))
+			for i, vote := range votes {
+				expected := records[i]
+				if reverse {
+					expected = records[len(records)-1-i]
+				}
+				require.Equal(t, expected.Height, vote.Height)
+				require.Equal(t, expected.TxHash, vote.TxHash)
+			}
+		}
+
+		// the start height between the recorded heights
+		votes, _, err := vs.QueryVoteRecords(btcPk, startHeight+numVotes+10, 1, true)
+		require.NoError(t, err)
+		require.Equal(t, startHeight+numVotes-1, votes[0].Height)
+		votes, _, err = vs.QueryVoteRecords(otherBtcPk, 0, limit, false)
+		require.NoError(t, err)
+		require.Empty(t, votes)
+
+		// the votes are not in the order of time, as the oldest vote is overwritten
+		// by a new one, and an old vote is recorded at the highest height
+		err = vs.AddVoteRecords(btcPk, []*proto.VoteRecord{
+			{
+				Height:    startHeight,
+				BlockHash: datagen.GenRandomByteArray(r, 32),
+				TxHash:    datagen.GenRandomHexStr(r, 32),
+				Timestamp: now.Unix(),
+			},
+			{
+				Height:    startHeight + numVotes,
+				BlockHash: datagen.GenRandomByteArray(r, 32),
+				TxHash:    datagen.GenRandomHexStr(r, 32),
+				Timestamp: now.Add(-2 * time.Hour).Unix(),
+			},
+		})
+		require.NoError(t, err)
+
+		// prune the old votes
+		pruned, err := vs.PruneVoteRecords(now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, numVotes/2, pruned)
+		votes, _, err = vs.QueryVoteRecords(btcPk, 0, uint32(numVotes+1), false)
+		require.NoError(t, err)
+		require.Len(t, votes, int(numVotes+1-numVotes/2))
+		require.Equal(t, startHeight, votes[0].Height)
+		require.Equal(t, startHeight+numVotes/2, votes[1].Height)
+		require.Equal(t, startHeight+numVotes-1, votes[len(votes)-1].Height)
+	})
+}
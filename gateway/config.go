@@ -0,0 +1,34 @@
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+// Config is the config of the REST gateway translating the JSON requests over HTTP into the
+// calls of the gRPC API of a daemon. The gateway is disabled if the listener is empty
+type Config struct {
+	Listener string `long:"listener" description:"The address that the REST gateway listens to, either host:port or unix:///path/to/socket; empty to disable the gateway"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+func (cfg *Config) Validate() error {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	if err := util.ValidateListenAddr(cfg.Listener); err != nil {
+		return fmt.Errorf("invalid REST gateway listener address: %w", err)
+	}
+
+	return nil
+}
+
+// Enabled returns whether the REST gateway is enabled, which is false if the config is nil
+func (cfg *Config) Enabled() bool {
+	return cfg != nil && cfg.Listener != ""
+}
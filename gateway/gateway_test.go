@@ -0,0 +1,147 @@
+package gateway_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/peer"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/proto"
+	"github.com/babylonlabs-io/finality-provider/gateway"
+	"github.com/babylonlabs-io/finality-provider/rpcauth"
+	"github.com/babylonlabs-io/finality-provider/testutil"
+)
+
+// TestHexJSONMarshaler tests that the byte fields are encoded in hex, except the chain IDs
+// encoded as texts, and that the encoded messages are decoded into the same messages
+func TestHexJSONMarshaler(t *testing.T) {
+	m := &gateway.HexJSONMarshaler{}
+	req := &proto.SignEOTSRequest{
+		Uid:     []byte{0x01, 0xab, 0xff},
+		ChainId: []byte("chain-test"),
+		Msg:     []byte{0xde, 0xad, 0xbe, 0xef},
+		Height:  1 << 60,
+	}
+
+	data, err := m.Marshal(req)
+	require.NoError(t, err)
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &fields))
+	require.Equal(t, "01abff", fields["uid"])
+	require.Equal(t, "chain-test", fields["chain_id"])
+	require.Equal(t, "deadbeef", fields["msg"])
+	require.Equal(t, fmt.Sprint(uint64(1<<60)), fields["height"])
+
+	decoded := &proto.SignEOTSRequest{}
+	require.NoError(t, m.Unmarshal(data, decoded))
+	require.True(t, protobuf.Equal(req, decoded))
+
+	resp := &proto.SignEOTSBatchResponse{Sigs: [][]byte{{0x01}, {0x02, 0x03}}}
+	data, err = m.Marshal(resp)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"sigs":["01","0203"]}`, string(data))
+
+	err = m.Unmarshal([]byte(`{"uid":"not-hex"}`), &proto.SignEOTSRequest{})
+	require.ErrorContains(t, err, "invalid hex string of the field uid")
+}
+
+// TestGatewayTokenAuth tests that the gateway serves the JSON API translated into the gRPC
+// calls only with an accepted bearer token
+func TestGatewayTokenAuth(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "tokens")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token-a\n"), 0600))
+
+	cfg := &gateway.Config{Listener: fmt.Sprintf("127.0.0.1:%d", testutil.AllocateUniquePort(t))}
+	require.NoError(t, cfg.Validate())
+	register := func(ctx context.Context, mux *runtime.ServeMux) error {
+		return proto.RegisterEOTSManagerHandlerServer(ctx, mux, &stubEOTSManager{})
+	}
+	gw, err := gateway.NewServer(cfg, &rpcauth.ServerConfig{TokenFile: tokenFile}, proto.OpenAPISpec, register, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, gw.Start(nil))
+	t.Cleanup(func() { gw.Stop(context.Background()) })
+
+	baseURL := "http://" + cfg.Listener
+	body := `{"uid":"01abff","chain_id":"chain-test","msg":"deadbeef","height":"10"}`
+
+	var code int
+	require.Eventually(t, func() bool {
+		code, _ = post(t, baseURL+"/v1/sign/eots", "", body)
+		return code != 0
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	code, _ = post(t, baseURL+"/v1/sign/eots", "token-b", body)
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	code, respBody := post(t, baseURL+"/v1/sign/eots", "token-a", body)
+	require.Equal(t, http.StatusOK, code)
+	// the stub signs the concatenation of the uid, chain ID and message
+	require.JSONEq(t, fmt.Sprintf(`{"sig":"01abff%sdeadbeef"}`, hex.EncodeToString([]byte("chain-test"))), respBody)
+
+	code, respBody = post(t, baseURL+"/v1/sign/eots", "token-a", `{"uid":"zz"}`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, respBody, "invalid hex string")
+
+	req, err := http.NewRequest(http.MethodGet, baseURL+gateway.OpenAPIPath, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer token-a")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	spec, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, string(spec), `"/v1/sign/eots"`)
+	require.Contains(t, string(spec), `"format": "hex"`)
+	require.NotContains(t, string(spec), `"format": "byte"`)
+}
+
+func post(t *testing.T, url, token, body string) (int, string) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, ""
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp.StatusCode, strings.TrimSpace(string(respBody))
+}
+
+// stubEOTSManager signs the EOTS requests with the concatenation of the request fields, and
+// requires the peer of the calls to be set
+type stubEOTSManager struct {
+	proto.UnimplementedEOTSManagerServer
+}
+
+func (s *stubEOTSManager) SignEOTS(ctx context.Context, req *proto.SignEOTSRequest) (*proto.SignEOTSResponse, error) {
+	if _, ok := peer.FromContext(ctx); !ok {
+		return nil, fmt.Errorf("missing peer")
+	}
+
+	sig := append(append(append([]byte{}, req.Uid...), req.ChainId...), req.Msg...)
+
+	return &proto.SignEOTSResponse{Sig: sig}, nil
+}
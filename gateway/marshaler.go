@@ -0,0 +1,216 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// textBytesFields are the byte fields holding texts rather than binary data, which are
+// encoded as the texts themselves rather than hex strings
+var textBytesFields = map[protoreflect.Name]bool{
+	"chain_id": true,
+}
+
+// HexJSONMarshaler is the marshaler of the REST gateway. It encodes the messages in JSON with
+// the field names of the proto files, and the byte fields, e.g., public keys, signatures and
+// app hashes, in hex rather than base64 as the rest of the finality provider does
+type HexJSONMarshaler struct{}
+
+var _ runtime.Marshaler = (*HexJSONMarshaler)(nil)
+
+func (*HexJSONMarshaler) ContentType() string {
+	return "application/json"
+}
+
+func (m *HexJSONMarshaler) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := toMessage(v)
+	if !ok {
+		return json.Marshal(v)
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(msg.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	return convertBytesFields(data, msg.Descriptor(), encodeBytes)
+}
+
+func (m *HexJSONMarshaler) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := toMessage(v)
+	if !ok {
+		return json.Unmarshal(data, v)
+	}
+
+	data, err := convertBytesFields(data, msg.Descriptor(), decodeBytes)
+	if err != nil {
+		return err
+	}
+
+	return protojson.Unmarshal(data, msg.Interface())
+}
+
+func (m *HexJSONMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v interface{}) error {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(data)) == 0 {
+			return io.EOF
+		}
+
+		return m.Unmarshal(data, v)
+	})
+}
+
+func (m *HexJSONMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v interface{}) error {
+		data, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+
+		return err
+	})
+}
+
+// toMessage returns the reflection of the message if the value is a message of either the
+// current or the legacy protobuf API
+func toMessage(v interface{}) (protoreflect.Message, bool) {
+	switch msg := v.(type) {
+	case protoreflect.ProtoMessage:
+		return msg.ProtoReflect(), true
+	case protoiface.MessageV1:
+		return protoadapt.MessageV2Of(msg).ProtoReflect(), true
+	default:
+		return nil, false
+	}
+}
+
+// bytesConverter converts the JSON string of a byte field
+type bytesConverter func(fd protoreflect.FieldDescriptor, s string) (string, error)
+
+// encodeBytes converts the base64 string of a byte field encoded by protojson into hex
+func encodeBytes(fd protoreflect.FieldDescriptor, s string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	if textBytesFields[fd.Name()] {
+		return string(b), nil
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// decodeBytes converts the hex string of a byte field into base64 decoded by protojson
+func decodeBytes(fd protoreflect.FieldDescriptor, s string) (string, error) {
+	if textBytesFields[fd.Name()] {
+		return base64.StdEncoding.EncodeToString([]byte(s)), nil
+	}
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid hex string of the field %s: %w", fd.Name(), err)
+	}
+
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// convertBytesFields converts the byte fields of the JSON message of the given descriptor
+func convertBytesFields(data []byte, md protoreflect.MessageDescriptor, convert bytesConverter) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// keep the numbers as they are rather than rounding them into float64
+	dec.UseNumber()
+	var obj interface{}
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+
+	fields, ok := obj.(map[string]interface{})
+	if !ok {
+		// not a JSON object, left for protojson to reject
+		return data, nil
+	}
+	if err := convertMessage(fields, md, convert); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(fields)
+}
+
+func convertMessage(fields map[string]interface{}, md protoreflect.MessageDescriptor, convert bytesConverter) error {
+	// the well-known types have their own JSON encodings
+	if strings.HasPrefix(string(md.FullName()), "google.protobuf.") {
+		return nil
+	}
+
+	fds := md.Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		key := string(fd.Name())
+		if _, ok := fields[key]; !ok {
+			key = fd.JSONName()
+		}
+		v, ok := fields[key]
+		if !ok || v == nil {
+			continue
+		}
+
+		var err error
+		switch {
+		case fd.IsMap():
+			if entries, ok := v.(map[string]interface{}); ok {
+				for k, entry := range entries {
+					if entries[k], err = convertValue(entry, fd.MapValue(), convert); err != nil {
+						return err
+					}
+				}
+			}
+		case fd.IsList():
+			if items, ok := v.([]interface{}); ok {
+				for j, item := range items {
+					if items[j], err = convertValue(item, fd, convert); err != nil {
+						return err
+					}
+				}
+			}
+		default:
+			if fields[key], err = convertValue(v, fd, convert); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// convertValue converts a single value of the field, i.e., an item if the field is repeated
+func convertValue(v interface{}, fd protoreflect.FieldDescriptor, convert bytesConverter) (interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		if s, ok := v.(string); ok {
+			return convert(fd, s)
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if fields, ok := v.(map[string]interface{}); ok {
+			return fields, convertMessage(fields, fd.Message(), convert)
+		}
+	}
+
+	// the values of unexpected types are left for protojson to reject
+	return v, nil
+}
@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OpenAPIPath is the path of the REST gateway serving its OpenAPI spec
+const OpenAPIPath = "/openapi.json"
+
+// hexFormat is the OpenAPI format of the byte fields encoded in hex by the gateway
+const hexFormat = "hex"
+
+// openAPISpec adapts the OpenAPI spec generated from the proto file to the gateway, where
+// the byte fields are hex strings rather than base64 ones, and sets the version of the API
+func openAPISpec(spec []byte, version string) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI spec: %w", err)
+	}
+
+	if info, ok := doc["info"].(map[string]interface{}); ok {
+		info["version"] = version
+	}
+	rewriteByteFormats(doc, "")
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// rewriteByteFormats rewrites the byte formats of the schemas under the node, which is
+// the schema of the field of the given name if it is a property
+func rewriteByteFormats(node interface{}, field string) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if n["format"] == "byte" {
+			if textBytesFields[protoreflect.Name(field)] {
+				delete(n, "format")
+			} else {
+				n["format"] = hexFormat
+			}
+		}
+		for k, v := range n {
+			switch k {
+			case "properties":
+				if props, ok := v.(map[string]interface{}); ok {
+					for name, prop := range props {
+						rewriteByteFormats(prop, name)
+					}
+				}
+			case "items":
+				// the items of a repeated field
+				rewriteByteFormats(v, field)
+			default:
+				rewriteByteFormats(v, "")
+			}
+		}
+	case []interface{}:
+		for _, v := range n {
+			rewriteByteFormats(v, "")
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/peer"
+
+	"github.com/babylonlabs-io/finality-provider/rpcauth"
+	"github.com/babylonlabs-io/finality-provider/util"
+	"github.com/babylonlabs-io/finality-provider/version"
+)
+
+// RegisterFunc registers the handlers of a gRPC service to the gateway mux, e.g., the
+// generated RegisterXHandlerServer calling the gRPC server of the daemon in process
+type RegisterFunc func(ctx context.Context, mux *runtime.ServeMux) error
+
+// Server is the REST gateway of a daemon, which serves the JSON API translated into the
+// calls of the gRPC server, and the OpenAPI spec of the JSON API at /openapi.json
+type Server struct {
+	cfg        *Config
+	httpServer *http.Server
+	logger     *zap.Logger
+}
+
+// NewServer creates the REST gateway of the gRPC service registered by the given function,
+// which enforces the same authentication config as the gRPC server
+func NewServer(
+	cfg *Config,
+	authCfg *rpcauth.ServerConfig,
+	spec []byte,
+	register RegisterFunc,
+	logger *zap.Logger,
+) (*Server, error) {
+	gwMux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &HexJSONMarshaler{}))
+	if err := register(context.Background(), gwMux); err != nil {
+		return nil, fmt.Errorf("failed to register the REST gateway handlers: %w", err)
+	}
+
+	openAPI, err := openAPISpec(spec, version.Version())
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(OpenAPIPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openAPI)
+	})
+	mux.Handle("/", withPeer(gwMux))
+
+	handler, tlsCfg, err := authCfg.HTTPHandler(mux)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up the REST gateway authentication: %w", err)
+	}
+
+	return &Server{
+		cfg: cfg,
+		httpServer: &http.Server{
+			Handler:           handler,
+			TLSConfig:         tlsCfg,
+			ReadHeaderTimeout: 5 * time.Second,
+			IdleTimeout:       60 * time.Second,
+		},
+		logger: logger,
+	}, nil
+}
+
+// Start listens on the address of the config and serves the gateway in the background.
+// The socket config is only used if the address is a unix domain socket
+func (s *Server) Start(sockCfg *util.UnixSocketConfig) error {
+	lis, err := util.Listen(s.cfg.Listener, sockCfg)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.cfg.Listener, err)
+	}
+	if s.httpServer.TLSConfig != nil {
+		lis = tls.NewListener(lis, s.httpServer.TLSConfig)
+	}
+
+	go func() {
+		s.logger.Info("REST gateway listening", zap.String("address", lis.Addr().String()))
+		if err := s.httpServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("REST gateway stopped unexpectedly", zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+// Stop gracefully shuts down the gateway
+func (s *Server) Stop(ctx context.Context) {
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		s.logger.Error("REST gateway shutdown failed", zap.Error(err))
+	}
+}
+
+// withPeer passes the address of the HTTP client to the gRPC server as the peer of the
+// call, e.g., recorded in the audit log of the EOTS manager
+func withPeer(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := peer.NewContext(r.Context(), &peer.Peer{Addr: httpAddr(r.RemoteAddr)})
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// httpAddr is the remote address of an HTTP request
+type httpAddr string
+
+var _ net.Addr = httpAddr("")
+
+func (a httpAddr) Network() string { return "tcp" }
+
+func (a httpAddr) String() string { return string(a) }
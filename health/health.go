@@ -0,0 +1,170 @@
+package health
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// defaultCheckInterval is the interval between each run of the health checks
+const defaultCheckInterval = 10 * time.Second
+
+// Check returns an error if the subsystem is not healthy
+type Check func() error
+
+// Checker runs the health checks of the subsystems of a daemon periodically, and reports
+// their status through the standard gRPC health service, where the service named after each
+// subsystem has the status of the subsystem and the empty service has the overall status
+type Checker struct {
+	wg     sync.WaitGroup
+	quit   chan struct{}
+	server *grpchealth.Server
+	logger *zap.Logger
+
+	mu     sync.RWMutex
+	checks map[string]Check
+	// failures are the errors of the subsystems failing the last run of the checks,
+	// nil before the first run
+	failures map[string]error
+}
+
+func NewChecker(logger *zap.Logger) *Checker {
+	server := grpchealth.NewServer()
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return &Checker{
+		quit:   make(chan struct{}),
+		server: server,
+		logger: logger,
+		checks: make(map[string]Check),
+	}
+}
+
+// AddCheck adds the check of the subsystem, which should be added before the checker starts
+func (c *Checker) AddCheck(subsystem string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checks[subsystem] = check
+	c.server.SetServingStatus(subsystem, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// RegisterWithGrpcServer registers the gRPC health service with the passed root gRPC server
+func (c *Checker) RegisterWithGrpcServer(grpcServer *grpc.Server) {
+	healthpb.RegisterHealthServer(grpcServer, c.server)
+}
+
+// Start runs the checks immediately and then periodically until the checker stops
+func (c *Checker) Start() {
+	c.CheckAll()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(defaultCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.CheckAll()
+			case <-c.quit:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops running the checks and reports all the subsystems as not serving
+func (c *Checker) Stop() {
+	close(c.quit)
+	c.wg.Wait()
+	c.server.Shutdown()
+}
+
+// CheckAll runs all the checks and updates the status of the subsystems
+func (c *Checker) CheckAll() {
+	c.mu.RLock()
+	checks := make(map[string]Check, len(c.checks))
+	for subsystem, check := range c.checks {
+		checks[subsystem] = check
+	}
+	c.mu.RUnlock()
+
+	failures := make(map[string]error)
+	for subsystem, check := range checks {
+		if err := check(); err != nil {
+			failures[subsystem] = err
+			c.server.SetServingStatus(subsystem, healthpb.HealthCheckResponse_NOT_SERVING)
+			c.logger.Warn("the subsystem is unhealthy", zap.String("subsystem", subsystem), zap.Error(err))
+			continue
+		}
+		c.server.SetServingStatus(subsystem, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	overall := healthpb.HealthCheckResponse_SERVING
+	if len(failures) > 0 {
+		overall = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.server.SetServingStatus("", overall)
+
+	c.mu.Lock()
+	c.failures = failures
+	c.mu.Unlock()
+}
+
+// Ready returns an error listing the subsystems failing the last run of the checks,
+// or nil if all of them are healthy
+func (c *Checker) Ready() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.failures == nil {
+		return errors.New("the health checks have not run yet")
+	}
+
+	subsystems := make([]string, 0, len(c.failures))
+	for subsystem := range c.failures {
+		subsystems = append(subsystems, subsystem)
+	}
+	sort.Strings(subsystems)
+
+	errs := make([]error, 0, len(subsystems))
+	for _, subsystem := range subsystems {
+		errs = append(errs, fmt.Errorf("%s: %w", subsystem, c.failures[subsystem]))
+	}
+
+	return errors.Join(errs...)
+}
+
+// DBCheck checks the database can be read
+func DBCheck(db kvdb.Backend) Check {
+	return func() error {
+		return kvdb.View(db, func(tx kvdb.RTx) error { return nil }, func() {})
+	}
+}
+
+// KeyringCheck checks the directory of the keyring of the file-based backends can be read,
+// without unlocking the keyring, while the other backends are not checked
+func KeyringCheck(keyringDir string, backend string) Check {
+	return func() error {
+		switch backend {
+		case keyring.BackendTest, keyring.BackendFile:
+			_, err := os.ReadDir(filepath.Join(keyringDir, "keyring-"+backend))
+			return err
+		default:
+			return nil
+		}
+	}
+}
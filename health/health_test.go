@@ -0,0 +1,64 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/babylonlabs-io/finality-provider/health"
+)
+
+// TestChecker tests that the checker reports the status of each subsystem and the
+// overall status through the gRPC health service and its readiness
+func TestChecker(t *testing.T) {
+	checker := health.NewChecker(zap.NewNop())
+	var dbErr error
+	checker.AddCheck("db", func() error { return dbErr })
+	checker.AddCheck("keyring", func() error { return nil })
+	require.Error(t, checker.Ready())
+
+	s := grpc.NewServer()
+	checker.RegisterWithGrpcServer(s)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	defer s.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		res, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return res.Status
+	}
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+
+	checker.Start()
+	require.NoError(t, checker.Ready())
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status(""))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status("db"))
+
+	dbErr = errors.New("db is closed")
+	checker.CheckAll()
+	require.ErrorContains(t, checker.Ready(), "db: db is closed")
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status("db"))
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status("keyring"))
+
+	checker.Stop()
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status("keyring"))
+}
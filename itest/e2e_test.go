@@ -91,15 +91,15 @@ func TestDoubleSigning(t *testing.T) {
 		Height: finalizedBlocks[0].Height,
 		Hash:   datagen.GenRandomByteArray(r, 32),
 	}
-	_, extractedKey, err := fpIns.TestSubmitFinalitySignatureAndExtractPrivKey(b)
-	require.NoError(t, err)
+	// the signature is produced bypassing the double-sign protection to simulate the attack
+	extractedKey := tm.SubmitEquivocatingFinalitySig(t, fpIns, b)
 	require.NotNil(t, extractedKey)
 	localKey := tm.GetFpPrivKey(t, fpIns.GetBtcPkBIP340().MustMarshal())
 	require.True(t, localKey.Key.Equals(&extractedKey.Key) || localKey.Key.Negate().Equals(&extractedKey.Key))
 
 	t.Logf("the equivocation attack is successful")
 
-	tm.WaitForFpShutDown(t)
+	tm.WaitForFpShutDown(t, fpIns.GetBtcPkBIP340())
 
 	// try to start the finality providers and the slashed one should expect err
 	err = tm.Fpa.StartHandlingFinalityProvider(fpIns.GetBtcPkBIP340(), "")
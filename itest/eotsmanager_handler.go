@@ -16,6 +16,8 @@ type EOTSServerHandler struct {
 	t           *testing.T
 	interceptor *signal.Interceptor
 	eotsServer  *service.Server
+	// eotsManager is the in-process EOTS manager served by eotsServer
+	eotsManager *eotsmanager.LocalEOTSManager
 }
 
 func NewEOTSServerHandler(t *testing.T, cfg *config.Config, eotsHomeDir string, shutdownInterceptor signal.Interceptor) *EOTSServerHandler {
@@ -31,6 +33,7 @@ func NewEOTSServerHandler(t *testing.T, cfg *config.Config, eotsHomeDir string,
 		t:           t,
 		eotsServer:  eotsServer,
 		interceptor: &shutdownInterceptor,
+		eotsManager: eotsManager,
 	}
 }
 
@@ -18,6 +18,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/babylonlabs-io/babylon/btcstaking"
 	txformat "github.com/babylonlabs-io/babylon/btctxformatter"
+	"github.com/babylonlabs-io/babylon/crypto/eots"
 	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	bbntypes "github.com/babylonlabs-io/babylon/types"
@@ -25,6 +26,7 @@ import (
 	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
+	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -32,6 +34,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquerytypes "github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
@@ -40,6 +43,7 @@ import (
 	fpcc "github.com/babylonlabs-io/finality-provider/clientcontroller"
 	"github.com/babylonlabs-io/finality-provider/eotsmanager/client"
 	eotsconfig "github.com/babylonlabs-io/finality-provider/eotsmanager/config"
+	"github.com/babylonlabs-io/finality-provider/eotsmanager/randgenerator"
 	fpcfg "github.com/babylonlabs-io/finality-provider/finality-provider/config"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/service"
 	"github.com/babylonlabs-io/finality-provider/types"
@@ -140,7 +144,7 @@ func StartManager(t *testing.T) *TestManager {
 	eh := NewEOTSServerHandler(t, eotsCfg, eotsHomeDir, shutdownInterceptor)
 	eh.Start()
 	cfg.RpcListener = fmt.Sprintf("127.0.0.1:%d", testutil.AllocateUniquePort(t))
-	eotsCli, err := client.NewEOTSManagerGRpcClient(eotsCfg.RpcListener)
+	eotsCli, err := client.NewEOTSManagerGRpcClient(eotsCfg.RpcListener, nil, nil)
 	require.NoError(t, err)
 
 	// 4. prepare finality-provider
@@ -220,7 +224,7 @@ func StartManagerWithFinalityProvider(t *testing.T) (*TestManager, *service.Fina
 	require.NoError(t, err)
 	err = app.StartHandlingFinalityProvider(fpPk, passphrase)
 	require.NoError(t, err)
-	fpIns, err := app.GetFinalityProviderInstance()
+	fpIns, err := app.GetFinalityProviderInstance(fpPk)
 	require.NoError(t, err)
 	require.True(t, fpIns.IsRunning())
 	require.NoError(t, err)
@@ -410,9 +414,9 @@ func (tm *TestManager) WaitForNFinalizedBlocks(t *testing.T, n int) []*types.Blo
 	return blocks
 }
 
-func (tm *TestManager) WaitForFpShutDown(t *testing.T) {
+func (tm *TestManager) WaitForFpShutDown(t *testing.T, fpPk *bbntypes.BIP340PubKey) {
 	require.Eventually(t, func() bool {
-		_, err := tm.Fpa.GetFinalityProviderInstance()
+		_, err := tm.Fpa.GetFinalityProviderInstance(fpPk)
 		return err != nil
 	}, eventuallyWaitTimeOut, eventuallyPollTime)
 
@@ -441,12 +445,50 @@ func (tm *TestManager) StopAndRestartFpAfterNBlocks(t *testing.T, n int, fpIns *
 	require.NoError(t, err)
 }
 
+// GetFpPrivKey returns the EOTS private key of the finality provider from the in-process
+// EOTS manager, as the private key is not returned by its RPC server
 func (tm *TestManager) GetFpPrivKey(t *testing.T, fpPk []byte) *btcec.PrivateKey {
-	record, err := tm.EOTSClient.KeyRecord(fpPk, passphrase)
+	record, err := tm.EOTSServerHandler.eotsManager.KeyRecord(fpPk, passphrase)
 	require.NoError(t, err)
 	return record.PrivKey
 }
 
+// SubmitEquivocatingFinalitySig signs the given block locally with the EOTS private key of the
+// finality provider, bypassing the double-sign protection of both the EOTS manager and the
+// finality provider, submits the signature and returns the private key extracted from the
+// slashing evidence, which is nil if the finality provider is not slashed
+func (tm *TestManager) SubmitEquivocatingFinalitySig(
+	t *testing.T,
+	fpIns *service.FinalityProviderInstance,
+	b *types.BlockInfo,
+) *btcec.PrivateKey {
+	fpSk := tm.GetFpPrivKey(t, fpIns.GetBtcPkBIP340().MustMarshal())
+	privRand, pubRand := randgenerator.GenerateRandomness(fpSk.Serialize(), fpIns.GetChainID(), b.Height)
+
+	proof, err := tm.Fpa.GetPubRandProofStore().GetPubRandProof(fpIns.GetBtcPk(), fpIns.GetChainID(), b.Height, pubRand)
+	require.NoError(t, err)
+
+	msgToSign := append(sdk.Uint64ToBigEndian(b.Height), b.Hash...)
+	sig, err := eots.Sign(fpSk, privRand, msgToSign)
+	require.NoError(t, err)
+
+	res, err := tm.BBNClient.SubmitFinalitySig(fpIns.GetBtcPk(), b, pubRand, proof, sig)
+	require.NoError(t, err)
+
+	for _, ev := range res.Events {
+		if strings.Contains(ev.EventType, "EventSlashedFinalityProvider") {
+			var evidence ftypes.Evidence
+			err := jsonpb.UnmarshalString(ev.Attributes["evidence"], &evidence)
+			require.NoError(t, err)
+			extractedKey, err := evidence.ExtractBTCSK()
+			require.NoError(t, err)
+			return extractedKey
+		}
+	}
+
+	return nil
+}
+
 func (tm *TestManager) InsertCovenantSigForDelegation(t *testing.T, btcDel *bstypes.BTCDelegation) {
 	slashingTx := btcDel.SlashingTx
 	stakingTx := btcDel.StakingTx
@@ -17,6 +17,7 @@ type Config struct {
 	Host           string        `long:"host" description:"IP of the Prometheus server"`
 	Port           int           `long:"port" description:"Port of the Prometheus server"`
 	UpdateInterval time.Duration `long:"updateinterval" description:"The interval of Prometheus metrics updated"`
+	EnablePprof    bool          `long:"enablepprof" description:"Serve the pprof profiles under /debug/pprof/ of the Prometheus server"`
 }
 
 func (cfg *Config) Validate() error {
@@ -13,6 +13,8 @@ type EotsMetrics struct {
 	EotsFpTotalEotsSignCounter            *prometheus.CounterVec
 	EotsFpLastEotsSignHeight              *prometheus.GaugeVec
 	EotsFpTotalSchnorrSignCounter         *prometheus.CounterVec
+	EotsFpPolicyViolationCounter          *prometheus.CounterVec
+	EotsFrozen                            prometheus.Gauge
 }
 
 var eotsMetricsRegisterOnce sync.Once
@@ -61,6 +63,17 @@ func NewEotsMetrics() *EotsMetrics {
 				},
 				[]string{"fp_btc_pk_hex"},
 			),
+			EotsFpPolicyViolationCounter: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "eots_fp_policy_violation_counter",
+					Help: "Total number of signing requests refused by the signing policy",
+				},
+				[]string{"fp_btc_pk_hex", "rule"},
+			),
+			EotsFrozen: prometheus.NewGauge(prometheus.GaugeOpts{
+				Name: "eots_frozen",
+				Help: "Whether the EOTS manager is frozen, 1 if it refuses to sign",
+			}),
 		}
 
 		// Register the EOTS metrics with Prometheus
@@ -70,6 +83,8 @@ func NewEotsMetrics() *EotsMetrics {
 		prometheus.MustRegister(eotsMetricsInstance.EotsFpTotalEotsSignCounter)
 		prometheus.MustRegister(eotsMetricsInstance.EotsFpLastEotsSignHeight)
 		prometheus.MustRegister(eotsMetricsInstance.EotsFpTotalSchnorrSignCounter)
+		prometheus.MustRegister(eotsMetricsInstance.EotsFpPolicyViolationCounter)
+		prometheus.MustRegister(eotsMetricsInstance.EotsFrozen)
 	})
 
 	return eotsMetricsInstance
@@ -104,3 +119,18 @@ func (em *EotsMetrics) SetEotsFpLastEotsSignHeight(fpBtcPkHex string, height flo
 func (em *EotsMetrics) IncrementEotsFpTotalSchnorrSignCounter(fpBtcPkHex string) {
 	em.EotsFpTotalSchnorrSignCounter.WithLabelValues(fpBtcPkHex).Inc()
 }
+
+// IncrementEotsFpPolicyViolationCounter increments the counter of signing requests
+// refused by the given rule of the signing policy
+func (em *EotsMetrics) IncrementEotsFpPolicyViolationCounter(fpBtcPkHex string, rule string) {
+	em.EotsFpPolicyViolationCounter.WithLabelValues(fpBtcPkHex, rule).Inc()
+}
+
+// SetEotsFrozen sets whether the EOTS manager is frozen
+func (em *EotsMetrics) SetEotsFrozen(frozen bool) {
+	if frozen {
+		em.EotsFrozen.Set(1)
+	} else {
+		em.EotsFrozen.Set(0)
+	}
+}
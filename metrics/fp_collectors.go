@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/connectivity"
 
 	"github.com/babylonlabs-io/finality-provider/finality-provider/proto"
 	"github.com/babylonlabs-io/finality-provider/finality-provider/store"
@@ -29,6 +30,8 @@ type FpMetrics struct {
 	fpTotalCommittedRandomness      *prometheus.GaugeVec
 	fpTotalFailedVotes              *prometheus.CounterVec
 	fpTotalFailedRandomness         *prometheus.CounterVec
+	// EOTS manager connection metrics
+	eotsManagerConnectivityState *prometheus.GaugeVec
 	// time keeper
 	mu                     sync.Mutex
 	previousVoteByFp       map[string]*time.Time
@@ -135,6 +138,13 @@ func NewFpMetrics() *FpMetrics {
 				},
 				[]string{"fp_btc_pk_hex"},
 			),
+			eotsManagerConnectivityState: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "fp_eots_manager_connectivity_state",
+					Help: "The connectivity state of the connection to the remote EOTS manager, 1 for the current state and 0 for the others.",
+				},
+				[]string{"state"},
+			),
 			mu: sync.Mutex{},
 		}
 
@@ -154,6 +164,7 @@ func NewFpMetrics() *FpMetrics {
 		prometheus.MustRegister(fpMetricsInstance.fpLastCommittedRandomnessHeight)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedVotes)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedRandomness)
+		prometheus.MustRegister(fpMetricsInstance.eotsManagerConnectivityState)
 	})
 	return fpMetricsInstance
 }
@@ -243,6 +254,24 @@ func (fm *FpMetrics) IncrementFpTotalFailedRandomness(fpBtcPkHex string) {
 	fm.fpTotalFailedRandomness.WithLabelValues(fpBtcPkHex).Inc()
 }
 
+// RecordEOTSManagerConnectivityState records the current connectivity state of the connection
+// to the remote EOTS manager
+func (fm *FpMetrics) RecordEOTSManagerConnectivityState(state connectivity.State) {
+	for _, s := range []connectivity.State{
+		connectivity.Idle,
+		connectivity.Connecting,
+		connectivity.Ready,
+		connectivity.TransientFailure,
+		connectivity.Shutdown,
+	} {
+		value := 0.0
+		if s == state {
+			value = 1
+		}
+		fm.eotsManagerConnectivityState.WithLabelValues(s.String()).Set(value)
+	}
+}
+
 // RecordFpVoteTime records the time of a finality sig vote by a finality provider
 func (fm *FpMetrics) RecordFpVoteTime(fpBtcPkHex string) {
 	fm.mu.Lock()
@@ -0,0 +1,68 @@
+package rpcauth
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// DialOptions returns the options of a gRPC client authenticating by the config
+// The client connects without TLS or token if the config is nil
+func (cfg *ClientConfig) DialOptions() ([]grpc.DialOption, error) {
+	if cfg == nil {
+		return []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, nil
+	}
+
+	var opts []grpc.DialOption
+
+	if cfg.TLSCACertPath != "" {
+		pool, err := loadCertPool(cfg.TLSCACertPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the CA certificate: %w", err)
+		}
+		tlsCfg := &tls.Config{
+			RootCAs:    pool,
+			ServerName: cfg.TLSServerName,
+			MinVersion: tls.VersionTLS12,
+		}
+		if cfg.TLSCertPath != "" {
+			cert, err := tls.LoadX509KeyPair(cfg.TLSCertPath, cfg.TLSKeyPath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load the TLS client certificate: %w", err)
+			}
+			tlsCfg.Certificates = []tls.Certificate{cert}
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	if cfg.TokenFile != "" {
+		tokens, err := readTokens(cfg.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{token: string(tokens[0])}))
+	}
+
+	return opts, nil
+}
+
+// tokenCredentials attaches the bearer token to every request
+type tokenCredentials struct {
+	token string
+}
+
+func (c *tokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{authorizationHeader: bearerPrefix + c.token}, nil
+}
+
+// RequireTransportSecurity allows sending the token without TLS, e.g., over the loopback
+// interface, in which case the token is sent in plaintext
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
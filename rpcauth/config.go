@@ -0,0 +1,72 @@
+package rpcauth
+
+import (
+	"fmt"
+
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+// ServerConfig is the authentication config of a gRPC server. The server requires TLS if the
+// certificate is set, client certificates signed by the client CA if it is set, and bearer
+// tokens listed in the token file if it is set
+type ServerConfig struct {
+	TLSCertPath  string `long:"tlscertpath" description:"The path of the TLS certificate of the RPC server; empty to serve without TLS"`
+	TLSKeyPath   string `long:"tlskeypath" description:"The path of the TLS private key of the RPC server"`
+	ClientCAPath string `long:"clientcapath" description:"The path of the CA certificates verifying the client certificates; empty to not require client certificates"`
+	TokenFile    string `long:"tokenfile" description:"The path of the file of the bearer tokens accepted by the RPC server, one per line; empty to not require tokens"`
+}
+
+func DefaultServerConfig() *ServerConfig {
+	return &ServerConfig{}
+}
+
+func (cfg *ServerConfig) Validate() error {
+	if (cfg.TLSCertPath == "") != (cfg.TLSKeyPath == "") {
+		return fmt.Errorf("the TLS certificate and key should be set together")
+	}
+
+	if cfg.ClientCAPath != "" && cfg.TLSCertPath == "" {
+		return fmt.Errorf("the client CA requires the TLS certificate of the server")
+	}
+
+	for _, path := range []string{cfg.TLSCertPath, cfg.TLSKeyPath, cfg.ClientCAPath, cfg.TokenFile} {
+		if path != "" && !util.FileExists(path) {
+			return fmt.Errorf("the file %s does not exist", path)
+		}
+	}
+
+	return nil
+}
+
+// ClientConfig is the authentication config of a gRPC client. The client connects with TLS
+// if the CA certificate is set, presents its certificate if it is set, and sends the bearer
+// token in the token file if it is set
+type ClientConfig struct {
+	TLSCACertPath string `long:"tlscacertpath" description:"The path of the CA certificates verifying the server certificate; empty to connect without TLS"`
+	TLSServerName string `long:"tlsservername" description:"The name of the server in its certificate; empty to use the host of the server address"`
+	TLSCertPath   string `long:"tlscertpath" description:"The path of the TLS client certificate; empty to connect without a client certificate"`
+	TLSKeyPath    string `long:"tlskeypath" description:"The path of the TLS client private key"`
+	TokenFile     string `long:"tokenfile" description:"The path of the file of the bearer token sent to the RPC server; empty to not send a token"`
+}
+
+func DefaultClientConfig() *ClientConfig {
+	return &ClientConfig{}
+}
+
+func (cfg *ClientConfig) Validate() error {
+	if (cfg.TLSCertPath == "") != (cfg.TLSKeyPath == "") {
+		return fmt.Errorf("the TLS client certificate and key should be set together")
+	}
+
+	if cfg.TLSCertPath != "" && cfg.TLSCACertPath == "" {
+		return fmt.Errorf("the TLS client certificate requires the CA certificate of the server")
+	}
+
+	for _, path := range []string{cfg.TLSCACertPath, cfg.TLSCertPath, cfg.TLSKeyPath, cfg.TokenFile} {
+		if path != "" && !util.FileExists(path) {
+			return fmt.Errorf("the file %s does not exist", path)
+		}
+	}
+
+	return nil
+}
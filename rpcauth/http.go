@@ -0,0 +1,40 @@
+package rpcauth
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+// HTTPHandler wraps the handler of an HTTP server serving the same API as the gRPC server,
+// e.g., the REST gateway, to enforce the same authentication config. It also returns the
+// TLS config the HTTP server should serve with, which is nil if the config has no TLS
+// certificate. The handler is returned as it is if the config is nil
+func (cfg *ServerConfig) HTTPHandler(h http.Handler) (http.Handler, *tls.Config, error) {
+	if cfg == nil {
+		return h, nil, nil
+	}
+
+	tlsCfg, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cfg.TokenFile == "" {
+		return h, tlsCfg, nil
+	}
+
+	tokens, err := readTokens(cfg.TokenFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	a := &tokenAuthenticator{tokens: tokens}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.accepts(r.Header.Values(authorizationHeader)) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	}), tlsCfg, nil
+}
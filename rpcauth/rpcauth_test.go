@@ -0,0 +1,179 @@
+package rpcauth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonlabs-io/finality-provider/rpcauth"
+)
+
+// TestMTLSAndTokenAuth tests that the server only serves the clients presenting
+// a certificate signed by the client CA and an accepted bearer token, except the
+// health checks, which need no bearer token
+func TestMTLSAndTokenAuth(t *testing.T) {
+	dir := t.TempDir()
+	caCert, caKey := genCert(t, dir, "ca", nil, nil)
+	genCert(t, dir, "server", caCert, caKey)
+	genCert(t, dir, "client", caCert, caKey)
+	otherCA, otherCAKey := genCert(t, dir, "other-ca", nil, nil)
+	genCert(t, dir, "other-client", otherCA, otherCAKey)
+	tokenFile := filepath.Join(dir, "tokens")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token-a\n\ntoken-b\n"), 0600))
+	wrongTokenFile := filepath.Join(dir, "wrong-token")
+	require.NoError(t, os.WriteFile(wrongTokenFile, []byte("token-c\n"), 0600))
+
+	serverCfg := &rpcauth.ServerConfig{
+		TLSCertPath:  filepath.Join(dir, "server.crt"),
+		TLSKeyPath:   filepath.Join(dir, "server.key"),
+		ClientCAPath: filepath.Join(dir, "ca.crt"),
+		TokenFile:    tokenFile,
+	}
+	require.NoError(t, serverCfg.Validate())
+	addr := startServer(t, serverCfg)
+
+	clientCfg := &rpcauth.ClientConfig{
+		TLSCACertPath: filepath.Join(dir, "ca.crt"),
+		TLSCertPath:   filepath.Join(dir, "client.crt"),
+		TLSKeyPath:    filepath.Join(dir, "client.key"),
+		TokenFile:     tokenFile,
+	}
+	require.NoError(t, clientCfg.Validate())
+	require.NoError(t, check(t, addr, clientCfg))
+
+	wrongToken := *clientCfg
+	wrongToken.TokenFile = wrongTokenFile
+	require.Equal(t, codes.Unauthenticated, status.Code(check(t, addr, &wrongToken)))
+
+	noToken := *clientCfg
+	noToken.TokenFile = ""
+	require.Equal(t, codes.Unauthenticated, status.Code(check(t, addr, &noToken)))
+	require.NoError(t, checkHealth(t, addr, &noToken))
+	require.NoError(t, checkHealth(t, addr, &wrongToken))
+
+	untrustedCert := *clientCfg
+	untrustedCert.TLSCertPath = filepath.Join(dir, "other-client.crt")
+	untrustedCert.TLSKeyPath = filepath.Join(dir, "other-client.key")
+	require.Error(t, check(t, addr, &untrustedCert))
+	require.Error(t, checkHealth(t, addr, &untrustedCert))
+
+	noCert := *clientCfg
+	noCert.TLSCertPath = ""
+	noCert.TLSKeyPath = ""
+	require.Error(t, check(t, addr, &noCert))
+
+	require.Error(t, check(t, addr, nil))
+}
+
+func startServer(t *testing.T, cfg *rpcauth.ServerConfig) string {
+	opts, err := cfg.ServerOptions()
+	require.NoError(t, err)
+	s := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	reflection.Register(s)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	return lis.Addr().String()
+}
+
+// check lists the services of the server through the reflection service,
+// which requires the authentication
+func check(t *testing.T, addr string, cfg *rpcauth.ClientConfig) error {
+	opts, err := cfg.DialOptions()
+	require.NoError(t, err)
+	conn, err := grpc.Dial(addr, opts...)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}); err != nil {
+		return err
+	}
+	_, err = stream.Recv()
+
+	return err
+}
+
+func checkHealth(t *testing.T, addr string, cfg *rpcauth.ClientConfig) error {
+	opts, err := cfg.DialOptions()
+	require.NoError(t, err)
+	conn, err := grpc.Dial(addr, opts...)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+
+	return err
+}
+
+// genCert writes a certificate and its key to dir/name.crt and dir/name.key, which is
+// self-signed if the parent is nil
+func genCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"), certPem, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), keyPem, 0600))
+
+	return cert, key
+}
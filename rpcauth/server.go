@@ -0,0 +1,185 @@
+package rpcauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// healthMethodPrefix is the prefix of the methods of the gRPC health service, which are
+// served without a bearer token so that the probes of the orchestrators keep working
+var healthMethodPrefix = "/" + healthpb.Health_ServiceDesc.ServiceName + "/"
+
+// ServerOptions returns the options of a gRPC server enforcing the authentication config
+// No option is returned if the config is nil
+func (cfg *ServerConfig) ServerOptions() ([]grpc.ServerOption, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	var opts []grpc.ServerOption
+
+	tlsCfg, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsCfg != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+
+	if cfg.TokenFile != "" {
+		tokens, err := readTokens(cfg.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		a := &tokenAuthenticator{tokens: tokens}
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(a.unaryInterceptor),
+			grpc.ChainStreamInterceptor(a.streamInterceptor),
+		)
+	}
+
+	return opts, nil
+}
+
+// tlsConfig returns the TLS config of the server, which is nil if the TLS certificate is not set
+func (cfg *ServerConfig) tlsConfig() (*tls.Config, error) {
+	if cfg.TLSCertPath == "" {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertPath, cfg.TLSKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS certificate: %w", err)
+	}
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.ClientCAPath != "" {
+		pool, err := loadCertPool(cfg.ClientCAPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client CA: %w", err)
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsCfg, nil
+}
+
+// tokenAuthenticator rejects the requests without any of the accepted bearer tokens,
+// except the ones of the gRPC health service
+type tokenAuthenticator struct {
+	tokens [][]byte
+}
+
+func (a *tokenAuthenticator) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+		if err := a.authenticate(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func (a *tokenAuthenticator) streamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if !strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+		if err := a.authenticate(ss.Context()); err != nil {
+			return err
+		}
+	}
+
+	return handler(srv, ss)
+}
+
+func (a *tokenAuthenticator) authenticate(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	if !a.accepts(md.Get(authorizationHeader)) {
+		return status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+
+	return nil
+}
+
+// accepts returns whether any of the authorization header values carries an accepted bearer token
+func (a *tokenAuthenticator) accepts(authorizations []string) bool {
+	for _, v := range authorizations {
+		if !strings.HasPrefix(v, bearerPrefix) {
+			continue
+		}
+		token := []byte(strings.TrimPrefix(v, bearerPrefix))
+		for _, accepted := range a.tokens {
+			if subtle.ConstantTimeCompare(token, accepted) == 1 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// readTokens reads the non-empty lines of the token file as tokens
+func readTokens(path string) ([][]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the token file: %w", err)
+	}
+
+	var tokens [][]byte
+	for _, line := range strings.Split(string(content), "\n") {
+		if token := strings.TrimSpace(line); token != "" {
+			tokens = append(tokens, []byte(token))
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no token in the token file %s", path)
+	}
+
+	return tokens, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate in %s", path)
+	}
+
+	return pool, nil
+}
@@ -8,9 +8,10 @@ import (
 	reflect "reflect"
 
 	math "cosmossdk.io/math"
-	types "github.com/babylonlabs-io/babylon/x/btcstaking/types"
-	types0 "github.com/babylonlabs-io/babylon/x/finality/types"
-	types1 "github.com/babylonlabs-io/finality-provider/types"
+	types "github.com/babylonlabs-io/babylon/types"
+	types0 "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	types1 "github.com/babylonlabs-io/babylon/x/finality/types"
+	types2 "github.com/babylonlabs-io/finality-provider/types"
 	btcec "github.com/btcsuite/btcd/btcec/v2"
 	schnorr "github.com/btcsuite/btcd/btcec/v2/schnorr"
 	gomock "github.com/golang/mock/gomock"
@@ -54,10 +55,10 @@ func (mr *MockClientControllerMockRecorder) Close() *gomock.Call {
 }
 
 // CommitPubRandList mocks base method.
-func (m *MockClientController) CommitPubRandList(fpPk *btcec.PublicKey, startHeight, numPubRand uint64, commitment []byte, sig *schnorr.Signature) (*types1.TxResponse, error) {
+func (m *MockClientController) CommitPubRandList(fpPk *btcec.PublicKey, startHeight, numPubRand uint64, commitment []byte, sig *schnorr.Signature) (*types2.TxResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CommitPubRandList", fpPk, startHeight, numPubRand, commitment, sig)
-	ret0, _ := ret[0].(*types1.TxResponse)
+	ret0, _ := ret[0].(*types2.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -69,10 +70,10 @@ func (mr *MockClientControllerMockRecorder) CommitPubRandList(fpPk, startHeight,
 }
 
 // EditFinalityProvider mocks base method.
-func (m *MockClientController) EditFinalityProvider(fpPk *btcec.PublicKey, commission *math.LegacyDec, description []byte) (*types.MsgEditFinalityProvider, error) {
+func (m *MockClientController) EditFinalityProvider(fpPk *btcec.PublicKey, commission *math.LegacyDec, description []byte) (*types0.MsgEditFinalityProvider, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "EditFinalityProvider", fpPk, commission, description)
-	ret0, _ := ret[0].(*types.MsgEditFinalityProvider)
+	ret0, _ := ret[0].(*types0.MsgEditFinalityProvider)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -99,10 +100,10 @@ func (mr *MockClientControllerMockRecorder) QueryActivatedHeight() *gomock.Call
 }
 
 // QueryBestBlock mocks base method.
-func (m *MockClientController) QueryBestBlock() (*types1.BlockInfo, error) {
+func (m *MockClientController) QueryBestBlock() (*types2.BlockInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryBestBlock")
-	ret0, _ := ret[0].(*types1.BlockInfo)
+	ret0, _ := ret[0].(*types2.BlockInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -114,10 +115,10 @@ func (mr *MockClientControllerMockRecorder) QueryBestBlock() *gomock.Call {
 }
 
 // QueryBlock mocks base method.
-func (m *MockClientController) QueryBlock(height uint64) (*types1.BlockInfo, error) {
+func (m *MockClientController) QueryBlock(height uint64) (*types2.BlockInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryBlock", height)
-	ret0, _ := ret[0].(*types1.BlockInfo)
+	ret0, _ := ret[0].(*types2.BlockInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -129,10 +130,10 @@ func (mr *MockClientControllerMockRecorder) QueryBlock(height interface{}) *gomo
 }
 
 // QueryBlocks mocks base method.
-func (m *MockClientController) QueryBlocks(startHeight, endHeight uint64, limit uint32) ([]*types1.BlockInfo, error) {
+func (m *MockClientController) QueryBlocks(startHeight, endHeight uint64, limit uint32) ([]*types2.BlockInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryBlocks", startHeight, endHeight, limit)
-	ret0, _ := ret[0].([]*types1.BlockInfo)
+	ret0, _ := ret[0].([]*types2.BlockInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -143,6 +144,21 @@ func (mr *MockClientControllerMockRecorder) QueryBlocks(startHeight, endHeight,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBlocks", reflect.TypeOf((*MockClientController)(nil).QueryBlocks), startHeight, endHeight, limit)
 }
 
+// QueryFinalityProvider mocks base method.
+func (m *MockClientController) QueryFinalityProvider(fpPk *btcec.PublicKey) (*types0.QueryFinalityProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryFinalityProvider", fpPk)
+	ret0, _ := ret[0].(*types0.QueryFinalityProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryFinalityProvider indicates an expected call of QueryFinalityProvider.
+func (mr *MockClientControllerMockRecorder) QueryFinalityProvider(fpPk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFinalityProvider", reflect.TypeOf((*MockClientController)(nil).QueryFinalityProvider), fpPk)
+}
+
 // QueryFinalityProviderSlashedOrJailed mocks base method.
 func (m *MockClientController) QueryFinalityProviderSlashedOrJailed(fpPk *btcec.PublicKey) (bool, bool, error) {
 	m.ctrl.T.Helper()
@@ -175,10 +191,10 @@ func (mr *MockClientControllerMockRecorder) QueryFinalityProviderVotingPower(fpP
 }
 
 // QueryLastCommittedPublicRand mocks base method.
-func (m *MockClientController) QueryLastCommittedPublicRand(fpPk *btcec.PublicKey, count uint64) (map[uint64]*types0.PubRandCommitResponse, error) {
+func (m *MockClientController) QueryLastCommittedPublicRand(fpPk *btcec.PublicKey, count uint64) (map[uint64]*types1.PubRandCommitResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryLastCommittedPublicRand", fpPk, count)
-	ret0, _ := ret[0].(map[uint64]*types0.PubRandCommitResponse)
+	ret0, _ := ret[0].(map[uint64]*types1.PubRandCommitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -190,10 +206,10 @@ func (mr *MockClientControllerMockRecorder) QueryLastCommittedPublicRand(fpPk, c
 }
 
 // QueryLatestFinalizedBlocks mocks base method.
-func (m *MockClientController) QueryLatestFinalizedBlocks(count uint64) ([]*types1.BlockInfo, error) {
+func (m *MockClientController) QueryLatestFinalizedBlocks(count uint64) ([]*types2.BlockInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryLatestFinalizedBlocks", count)
-	ret0, _ := ret[0].([]*types1.BlockInfo)
+	ret0, _ := ret[0].([]*types2.BlockInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -204,11 +220,26 @@ func (mr *MockClientControllerMockRecorder) QueryLatestFinalizedBlocks(count int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryLatestFinalizedBlocks", reflect.TypeOf((*MockClientController)(nil).QueryLatestFinalizedBlocks), count)
 }
 
+// QueryVotesAtHeight mocks base method.
+func (m *MockClientController) QueryVotesAtHeight(height uint64) ([]types.BIP340PubKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryVotesAtHeight", height)
+	ret0, _ := ret[0].([]types.BIP340PubKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryVotesAtHeight indicates an expected call of QueryVotesAtHeight.
+func (mr *MockClientControllerMockRecorder) QueryVotesAtHeight(height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryVotesAtHeight", reflect.TypeOf((*MockClientController)(nil).QueryVotesAtHeight), height)
+}
+
 // RegisterFinalityProvider mocks base method.
-func (m *MockClientController) RegisterFinalityProvider(fpPk *btcec.PublicKey, pop []byte, commission *math.LegacyDec, description []byte) (*types1.TxResponse, error) {
+func (m *MockClientController) RegisterFinalityProvider(fpPk *btcec.PublicKey, pop []byte, commission *math.LegacyDec, description []byte) (*types2.TxResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RegisterFinalityProvider", fpPk, pop, commission, description)
-	ret0, _ := ret[0].(*types1.TxResponse)
+	ret0, _ := ret[0].(*types2.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -220,10 +251,10 @@ func (mr *MockClientControllerMockRecorder) RegisterFinalityProvider(fpPk, pop,
 }
 
 // SubmitBatchFinalitySigs mocks base method.
-func (m *MockClientController) SubmitBatchFinalitySigs(fpPk *btcec.PublicKey, blocks []*types1.BlockInfo, pubRandList []*btcec.FieldVal, proofList [][]byte, sigs []*btcec.ModNScalar) (*types1.TxResponse, error) {
+func (m *MockClientController) SubmitBatchFinalitySigs(fpPk *btcec.PublicKey, blocks []*types2.BlockInfo, pubRandList []*btcec.FieldVal, proofList [][]byte, sigs []*btcec.ModNScalar) (*types2.TxResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SubmitBatchFinalitySigs", fpPk, blocks, pubRandList, proofList, sigs)
-	ret0, _ := ret[0].(*types1.TxResponse)
+	ret0, _ := ret[0].(*types2.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -235,10 +266,10 @@ func (mr *MockClientControllerMockRecorder) SubmitBatchFinalitySigs(fpPk, blocks
 }
 
 // SubmitFinalitySig mocks base method.
-func (m *MockClientController) SubmitFinalitySig(fpPk *btcec.PublicKey, block *types1.BlockInfo, pubRand *btcec.FieldVal, proof []byte, sig *btcec.ModNScalar) (*types1.TxResponse, error) {
+func (m *MockClientController) SubmitFinalitySig(fpPk *btcec.PublicKey, block *types2.BlockInfo, pubRand *btcec.FieldVal, proof []byte, sig *btcec.ModNScalar) (*types2.TxResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SubmitFinalitySig", fpPk, block, pubRand, proof, sig)
-	ret0, _ := ret[0].(*types1.TxResponse)
+	ret0, _ := ret[0].(*types2.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -250,10 +281,10 @@ func (mr *MockClientControllerMockRecorder) SubmitFinalitySig(fpPk, block, pubRa
 }
 
 // UnjailFinalityProvider mocks base method.
-func (m *MockClientController) UnjailFinalityProvider(fpPk *btcec.PublicKey) (*types1.TxResponse, error) {
+func (m *MockClientController) UnjailFinalityProvider(fpPk *btcec.PublicKey) (*types2.TxResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UnjailFinalityProvider", fpPk)
-	ret0, _ := ret[0].(*types1.TxResponse)
+	ret0, _ := ret[0].(*types2.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -38,6 +38,8 @@ func PrepareMockedClientController(t *testing.T, r *rand.Rand, startHeight, curr
 	mockClientController.EXPECT().Close().Return(nil).AnyTimes()
 	mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
 	mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+	// no votes on the chain to recover the state of the finality providers from
+	mockClientController.EXPECT().QueryVotesAtHeight(gomock.Any()).Return(nil, nil).AnyTimes()
 
 	return mockClientController
 }
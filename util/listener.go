@@ -0,0 +1,194 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UnixSocketPrefix is the prefix of the RPC addresses of unix domain sockets, e.g.,
+// unix:///var/run/eotsd.sock
+const UnixSocketPrefix = "unix://"
+
+// UnixSocketConfig is the file mode and ownership of the unix domain socket of an RPC
+// listener, which is only used if the listener address is a unix domain socket
+type UnixSocketConfig struct {
+	Mode  string `long:"mode" description:"The file mode of the unix socket in octal, e.g., 0600"`
+	Owner string `long:"owner" description:"The name or ID of the user owning the unix socket; empty to keep the user running the daemon"`
+	Group string `long:"group" description:"The name or ID of the group owning the unix socket; empty to keep the group of the user running the daemon"`
+}
+
+func DefaultUnixSocketConfig() *UnixSocketConfig {
+	return &UnixSocketConfig{
+		Mode: "0600",
+	}
+}
+
+func (cfg *UnixSocketConfig) Validate() error {
+	if _, err := cfg.fileMode(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cfg *UnixSocketConfig) fileMode() (os.FileMode, error) {
+	if cfg.Mode == "" {
+		return 0, fmt.Errorf("the unix socket mode should not be empty")
+	}
+
+	mode, err := strconv.ParseUint(cfg.Mode, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, fmt.Errorf("invalid unix socket mode %s", cfg.Mode)
+	}
+
+	return os.FileMode(mode), nil
+}
+
+// IsUnixSocketAddr returns whether the RPC address is a unix domain socket
+func IsUnixSocketAddr(addr string) bool {
+	return strings.HasPrefix(addr, UnixSocketPrefix)
+}
+
+// ValidateListenAddr checks the RPC listener address is either a TCP address or a unix
+// domain socket prefixed by unix://
+func ValidateListenAddr(addr string) error {
+	if IsUnixSocketAddr(addr) {
+		if strings.TrimPrefix(addr, UnixSocketPrefix) == "" {
+			return fmt.Errorf("empty unix socket path in %s", addr)
+		}
+		return nil
+	}
+
+	_, err := net.ResolveTCPAddr("tcp", addr)
+	return err
+}
+
+// GRPCDialTarget returns the gRPC target of the RPC address, which understands the unix
+// domain sockets of both relative and absolute paths
+func GRPCDialTarget(addr string) string {
+	if IsUnixSocketAddr(addr) {
+		return "unix:" + strings.TrimPrefix(addr, UnixSocketPrefix)
+	}
+
+	return addr
+}
+
+// Listen listens on the RPC address, which is either a TCP address or a unix domain socket
+// prefixed by unix://. The stale socket file left by a previous process is removed, and the
+// socket file gets the mode and ownership of the given config, which is only used for unix
+// domain sockets. The socket file is removed when the listener is closed
+func Listen(addr string, sockCfg *UnixSocketConfig) (net.Listener, error) {
+	if !IsUnixSocketAddr(addr) {
+		return net.Listen("tcp", addr)
+	}
+
+	path := strings.TrimPrefix(addr, UnixSocketPrefix)
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if sockCfg != nil {
+		if err := applySocketConfig(path, sockCfg); err != nil {
+			lis.Close()
+			return nil, err
+		}
+	}
+
+	return lis, nil
+}
+
+// removeStaleSocket removes the socket file at the path if no process listens on it
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a unix socket", path)
+	}
+
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("the unix socket %s is in use by another process", path)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove the stale unix socket %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func applySocketConfig(path string, cfg *UnixSocketConfig) error {
+	mode, err := cfg.fileMode()
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		return fmt.Errorf("failed to set the mode of the unix socket %s: %w", path, err)
+	}
+
+	if cfg.Owner == "" && cfg.Group == "" {
+		return nil
+	}
+
+	uid, gid := -1, -1
+	if cfg.Owner != "" {
+		if uid, err = lookupID(cfg.Owner, func(name string) (string, error) {
+			u, err := user.Lookup(name)
+			if err != nil {
+				return "", err
+			}
+			return u.Uid, nil
+		}); err != nil {
+			return fmt.Errorf("invalid unix socket owner %s: %w", cfg.Owner, err)
+		}
+	}
+	if cfg.Group != "" {
+		if gid, err = lookupID(cfg.Group, func(name string) (string, error) {
+			g, err := user.LookupGroup(name)
+			if err != nil {
+				return "", err
+			}
+			return g.Gid, nil
+		}); err != nil {
+			return fmt.Errorf("invalid unix socket group %s: %w", cfg.Group, err)
+		}
+	}
+
+	if err := os.Chown(path, uid, gid); err != nil {
+		return fmt.Errorf("failed to set the ownership of the unix socket %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// lookupID returns the numeric ID as is, or looks up the ID of the name
+func lookupID(nameOrID string, lookup func(string) (string, error)) (int, error) {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		return id, nil
+	}
+
+	id, err := lookup(nameOrID)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(id)
+}
@@ -0,0 +1,52 @@
+package util_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/finality-provider/util"
+)
+
+// TestUnixSocketListener tests that the unix socket listener replaces a stale
+// socket, refuses to take over a socket in use, and applies the file mode
+func TestUnixSocketListener(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rpc.sock")
+	addr := util.UnixSocketPrefix + path
+	require.NoError(t, util.ValidateListenAddr(addr))
+	require.Error(t, util.ValidateListenAddr(util.UnixSocketPrefix))
+	require.Equal(t, "unix:"+path, util.GRPCDialTarget(addr))
+
+	// leave a stale socket file behind
+	stale, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	lis, err := util.Listen(addr, &util.UnixSocketConfig{Mode: "0640"})
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0640), info.Mode().Perm())
+
+	// the socket is in use
+	_, err = util.Listen(addr, util.DefaultUnixSocketConfig())
+	require.Error(t, err)
+
+	// the socket file is removed on close
+	require.NoError(t, lis.Close())
+	_, err = os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// a regular file is never removed
+	require.NoError(t, os.WriteFile(path, []byte{}, 0600))
+	_, err = util.Listen(addr, util.DefaultUnixSocketConfig())
+	require.Error(t, err)
+
+	require.Error(t, (&util.UnixSocketConfig{Mode: "0999"}).Validate())
+}
@@ -135,6 +135,11 @@ func Version() string {
 	return fmt.Sprintf("%s commit=%s", semanticVersion(), Commit)
 }
 
+// SemanticVersion returns the SemVer part of the application version, e.g., 0.2.2-alpha
+func SemanticVersion() string {
+	return semanticVersion()
+}
+
 // Tags returns the list of build tags that were compiled into the executable.
 func Tags() []string {
 	if len(RawTags) == 0 {